@@ -0,0 +1,167 @@
+package sudoku
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Record is one line of the puzzle dataset.
+type Record struct {
+	Puzzle    Game    // the puzzle
+	Solution  Game    // solution of the puzzle, nil when the line has none
+	Rating    float64 // rating of the puzzle, zero when the line has none
+	HasRating bool    // whether the line has the rating, which can be zero as well
+	Line      int     // line of the record in the input, starting at 1
+}
+
+// Reader reads the datasets with one puzzle per line, such as:
+//
+//	..9748...7.........2.1.9.....7...24..64.1.59..98...3.....8.3.2.........6...2759..,3.2
+//
+// The puzzle is read by the rules of Parse, and can be followed by the solution of 81 cells, the numeric rating or
+// both of them. Fields are separated by commas, semicolons, spaces or tabs. Lines without any field or starting by '#'
+// are skipped, as well as the first record line, when its first field starts by a letter, such as "quizzes,solutions".
+type Reader struct {
+	s          *bufio.Scanner
+	line       int
+	seenRecord bool // whether any line other than blank or comment was read
+}
+
+// NewReader creates the Reader of the input, which is read line by line as needed.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{s: bufio.NewScanner(r)}
+}
+
+// Read method returns the next record, io.EOF when there are no more records. Invalid lines are reported by
+// *ParseError with the line number and the offset in the line.
+func (r *Reader) Read() (Record, error) {
+	for r.s.Scan() {
+		r.line++
+		text := strings.TrimRight(r.s.Text(), "\r")
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields, offsets := splitFields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		header := !r.seenRecord
+		r.seenRecord = true
+		if c, _ := utf8.DecodeRuneInString(fields[0]); header && unicode.IsLetter(c) {
+			continue
+		}
+
+		return r.record(fields, offsets)
+	}
+
+	if err := r.s.Err(); err != nil {
+		return Record{}, err
+	}
+
+	return Record{}, io.EOF
+}
+
+// Writer writes the records in the format read by the Reader.
+type Writer struct {
+	Comma rune // field separator, set to ',' by NewWriter
+	w     *bufio.Writer
+}
+
+// NewWriter creates the buffered Writer, the Flush method has to be called after the last record.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{Comma: ',', w: bufio.NewWriter(w)}
+}
+
+// Write method writes the record as one line with the compact puzzle followed by the solution and the rating, when
+// the record has them. The zero rating is written only when HasRating is set. The state error of any game is returned
+// and nothing is written.
+func (w *Writer) Write(rec Record) error {
+	if err := rec.Puzzle.Error(); err != nil {
+		return err
+	}
+
+	line := []byte(rec.Puzzle.Compact())
+	if rec.Solution != nil {
+		if err := rec.Solution.Error(); err != nil {
+			return err
+		}
+
+		line = append(append(line, string(w.Comma)...), rec.Solution.Compact()...)
+	}
+
+	if rec.HasRating || rec.Rating != 0 {
+		line = append(line, string(w.Comma)...)
+		line = strconv.AppendFloat(line, rec.Rating, 'f', -1, 64)
+	}
+
+	_, err := w.w.Write(append(line, '\n'))
+	return err
+}
+
+// Flush method writes any buffered records to the underlying writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+func (r *Reader) record(fields []string, offsets []int) (Record, error) {
+	rec := Record{Line: r.line}
+	for i, f := range fields {
+		var err error
+		switch {
+		case i == 0:
+			rec.Puzzle, err = Parse(f)
+		case i > 2:
+			err = &ParseError{Reason: "unexpected field"}
+		case utf8.RuneCountInString(f) >= BoardSize && rec.Solution == nil:
+			rec.Solution, err = Parse(f)
+		case !rec.HasRating:
+			rec.Rating, err = strconv.ParseFloat(f, 64)
+			if err != nil || rec.Rating < 0 || math.IsNaN(rec.Rating) {
+				err = &ParseError{Reason: "invalid rating " + strconv.Quote(f)}
+			}
+			rec.HasRating = true
+		default:
+			err = &ParseError{Reason: "unexpected field"}
+		}
+
+		if err != nil {
+			pe := err.(*ParseError)
+			pe.Line, pe.Offset = r.line, pe.Offset+offsets[i]
+			return Record{}, pe
+		}
+	}
+
+	return rec, nil
+}
+
+// splitFields splits the line by separators and returns the byte offsets of the fields.
+func splitFields(text string) ([]string, []int) {
+	var fields []string
+	var offsets []int
+	start := -1
+	for i, c := range text + "," {
+		if c == ',' || c == ';' || c == ' ' || c == '\t' {
+			if start >= 0 {
+				fields = append(fields, text[start:i])
+				offsets = append(offsets, start)
+				start = -1
+			}
+			continue
+		}
+
+		if start < 0 {
+			start = i
+		}
+	}
+
+	return fields, offsets
+}
@@ -0,0 +1,169 @@
+package sudoku
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReader_Read(t *testing.T) {
+	puzzle := hardestPuzzles[0].puzzle
+	solved := gameFromString(puzzle)
+	if err := solved.Solve(); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+	solution := solved.Compact()
+
+	input := "# dataset\n\nquizzes,solutions\r\n" +
+		puzzle + "," + solution + "\r\n" +
+		"\n# comment\n" +
+		puzzle + "\t10.5\n" +
+		puzzle + "; " + solution + "; 10.5\n" +
+		puzzle + "\n" +
+		puzzle + ",0\n"
+
+	r := NewReader(strings.NewReader(input))
+	expected := []struct {
+		line      int
+		solution  bool
+		rating    float64
+		hasRating bool
+	}{
+		{4, true, 0, false},
+		{7, false, 10.5, true},
+		{8, true, 10.5, true},
+		{9, false, 0, false},
+		{10, false, 0, true},
+	}
+
+	for _, e := range expected {
+		rec, err := r.Read()
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		if rec.Line != e.line || rec.Puzzle.Compact() != puzzle || rec.Rating != e.rating ||
+			rec.HasRating != e.hasRating {
+			t.Errorf("record of line %d with rating %v expected, got: %+v", e.line, e.rating, rec)
+		}
+
+		if e.solution && (rec.Solution == nil || rec.Solution.Compact() != solution) {
+			t.Errorf("line %d: solution expected, got: %v", e.line, rec.Solution)
+		}
+
+		if !e.solution && rec.Solution != nil {
+			t.Errorf("line %d: no solution expected, got: %v", e.line, rec.Solution)
+		}
+	}
+
+	if _, err := r.Read(); err != io.EOF {
+		t.Errorf("end of file expected, got: %v", err)
+	}
+}
+
+func TestReader_ReadErrors(t *testing.T) {
+	puzzle := hardestPuzzles[0].puzzle
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		offset int
+	}{
+		{"character", puzzle + "\n" + puzzle[:10] + "x" + puzzle[11:], 2, 10},
+		{"header", "puzzle\n" + "x" + puzzle, 2, 0},
+		{"short solution", puzzle + "," + puzzle[:80] + "x", 1, 82 + 80},
+		{"rating", puzzle + " hard", 1, 82},
+		{"negative rating", puzzle + " -1", 1, 82},
+		{"NaN rating", puzzle + " NaN", 1, 82},
+		{"second header", "# comment\nquizzes\nsolutions\n", 3, 0},
+		{"fields", puzzle + " 1 2", 1, 84},
+		{"many fields", puzzle + " " + puzzle + " 1 2", 1, 166},
+	}
+
+	for _, tc := range tests {
+		r := NewReader(strings.NewReader(tc.input))
+		var err error
+		for err == nil {
+			_, err = r.Read()
+		}
+
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("%s: parse error expected, got: %v", tc.name, err)
+		}
+
+		if pe.Line != tc.line || pe.Offset != tc.offset {
+			t.Errorf("%s: line %d and offset %d expected, got: %v", tc.name, tc.line, tc.offset, pe)
+		}
+	}
+
+	pe := &ParseError{Line: 2, Offset: 3, Reason: "too few cells, got 4"}
+	if pe.Error() != "parse error at line 2, offset 3: too few cells, got 4" {
+		t.Errorf("error message expected, got: %s", pe)
+	}
+}
+
+func TestWriter_Write(t *testing.T) {
+	buf := bytes.Buffer{}
+	w := NewWriter(&buf)
+	records := []Record{
+		{Puzzle: easyGame(), Solution: easyGameSolved(), Rating: 3.4},
+		{Puzzle: hardGame(), Rating: 3.2},
+		{Puzzle: singlesGame()},
+		{Puzzle: singlesGame(), HasRating: true},
+	}
+
+	for _, rec := range records {
+		if err := w.Write(rec); err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+	}
+
+	w.Comma = ' '
+	if err := w.Write(Record{Puzzle: hardGame(), Solution: hardGameSolved()}); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if err := w.Write(Record{Puzzle: NewBoard().SetValue(BoardSide, 0, 1)}); err != errOutOfBoardIndex {
+		t.Errorf("state error expected, got: %v", err)
+	}
+
+	if err := w.Flush(); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !strings.HasPrefix(buf.String(), easyGame().Compact()+","+easyGameSolved().Compact()+",3.4\n") {
+		t.Errorf("line with the solution and the rating expected, got:\n%s", buf.String())
+	}
+
+	// the records are read back
+	r := NewReader(&buf)
+	records = append(records, Record{Puzzle: hardGame(), Solution: hardGameSolved()})
+	for i, expected := range records {
+		rec, err := r.Read()
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		if !reflect.DeepEqual(rec.Puzzle.Board(), expected.Puzzle.Board()) || rec.Rating != expected.Rating ||
+			rec.HasRating != (expected.HasRating || expected.Rating != 0) ||
+			(rec.Solution == nil) != (expected.Solution == nil) || rec.Line != i+1 {
+			t.Errorf("record %d expected, got: %+v", i+1, rec)
+		}
+	}
+}
+
+func BenchmarkReader_Read(b *testing.B) {
+	line := hardestPuzzles[0].puzzle + "\n"
+	input := strings.Repeat(line, b.N)
+	b.ResetTimer()
+
+	r := NewReader(strings.NewReader(input))
+	for {
+		if _, err := r.Read(); err != nil {
+			break
+		}
+	}
+}
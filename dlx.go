@@ -0,0 +1,206 @@
+package sudoku
+
+import (
+	"context"
+	"time"
+)
+
+// Dimensions of the exact cover matrix. Every row is one digit placed into one cell and every column is one
+// constraint: a cell holds a digit, a row, a column and a box contain each digit.
+const (
+	dlxRows    = BoardSize * MaxValue // 729 possible placements
+	dlxColumns = 4 * BoardSize        // 324 constraints
+)
+
+// DancingLinks is the Solver based on Knuth's Algorithm X, where the Sudoku is modeled as the exact cover problem and
+// the matrix is kept in the dancing links structure.
+type DancingLinks struct {
+	MaxNodes int // maximal number of search nodes, zero means no limit
+}
+
+// Search calls found for every solution of the grid until found returns false or there are no more solutions.
+func (dl DancingLinks) Search(ctx context.Context, g Grid, found func(Grid) bool) (SolveStats, error) {
+	if _, err := g.board(); err != nil {
+		return SolveStats{}, err
+	}
+
+	start := time.Now()
+	m := newDLXMatrix()
+	m.limiter = limiter{ctx: ctx, maxNodes: dl.MaxNodes}
+	for idx, v := range g {
+		// the grid is valid, hence the clues never collide
+		if v > 0 {
+			m.selectRow(idx*MaxValue + v - 1)
+		}
+	}
+
+	m.search(func() bool {
+		solution := g
+		for _, row := range m.solution {
+			solution[row/MaxValue] = row%MaxValue + 1
+		}
+
+		m.solved(m.forced, m.depth)
+		return found(solution)
+	})
+
+	m.stats.Elapsed = time.Since(start)
+	return m.stats, m.err
+}
+
+// dlxMatrix is the sparse exact cover matrix. The node 0 is the root, nodes 1 - 324 are column headers and the rest
+// are the matrix cells, four for each row.
+type dlxMatrix struct {
+	limiter
+	left, right, up, down []int
+	column                []int // column header of the node
+	row                   []int // matrix row of the node
+	size                  []int // number of nodes in the column
+	first                 []int // first node of each matrix row
+	solution              []int // selected matrix rows
+	forced                int   // selected matrix rows without any alternative
+}
+
+func newDLXMatrix() *dlxMatrix {
+	n := 1 + dlxColumns + 4*dlxRows
+	m := &dlxMatrix{
+		left:     make([]int, n),
+		right:    make([]int, n),
+		up:       make([]int, n),
+		down:     make([]int, n),
+		column:   make([]int, n),
+		row:      make([]int, n),
+		size:     make([]int, dlxColumns+1),
+		first:    make([]int, dlxRows),
+		solution: make([]int, 0, BoardSize),
+	}
+
+	// root and column headers in one circular list
+	for c := 0; c <= dlxColumns; c++ {
+		m.left[c], m.right[c] = c-1, c+1
+		m.up[c], m.down[c] = c, c
+		m.column[c] = c
+	}
+	m.left[0], m.right[dlxColumns] = dlxColumns, 0
+
+	node := dlxColumns + 1
+	for r := 0; r < dlxRows; r++ {
+		idx, d := r/MaxValue, r%MaxValue
+		columns := [4]int{
+			1 + idx,
+			1 + BoardSize + rowOf[idx]*MaxValue + d,
+			1 + 2*BoardSize + columnOf[idx]*MaxValue + d,
+			1 + 3*BoardSize + boxOf[idx]*MaxValue + d,
+		}
+
+		m.first[r] = node
+		for i, c := range columns {
+			// append the node to the bottom of its column
+			m.up[node], m.down[node] = m.up[c], c
+			m.down[m.up[c]], m.up[c] = node, node
+			m.column[node], m.row[node] = c, r
+			m.size[c]++
+
+			// link the node into the circular row list
+			m.left[node], m.right[node] = node-1, node+1
+			if i == 0 {
+				m.left[node] = node + len(columns) - 1
+			}
+			if i == len(columns)-1 {
+				m.right[node] = m.first[r]
+			}
+			node++
+		}
+	}
+
+	return m
+}
+
+// selectRow puts the matrix row into the solution for good, it is used for clues.
+func (m *dlxMatrix) selectRow(r int) {
+	node := m.first[r]
+	m.cover(m.column[node])
+	for j := m.right[node]; j != node; j = m.right[j] {
+		m.cover(m.column[j])
+	}
+}
+
+func (m *dlxMatrix) search(found func() bool) bool {
+	if m.right[0] == 0 {
+		return found()
+	}
+
+	// the column with the fewest rows, no row means a dead end
+	c := m.right[0]
+	for j := m.right[c]; j != 0; j = m.right[j] {
+		if m.size[j] < m.size[c] {
+			c = j
+		}
+	}
+
+	if m.size[c] == 0 {
+		m.stats.Backtracks++
+		return true
+	}
+
+	m.cover(c)
+	defer m.uncover(c)
+
+	// the only row of the column is forced, the same as propagation, otherwise it is a guess
+	forced := m.size[c] == 1
+	for r := m.down[c]; r != c; r = m.down[r] {
+		if !m.next() {
+			return false
+		}
+
+		m.solution = append(m.solution, m.row[r])
+		for j := m.right[r]; j != r; j = m.right[j] {
+			m.cover(m.column[j])
+		}
+
+		if forced {
+			m.forced++
+		} else {
+			m.push()
+		}
+
+		ok := m.search(found)
+
+		if forced {
+			m.forced--
+		} else {
+			m.pop()
+		}
+
+		for j := m.left[r]; j != r; j = m.left[j] {
+			m.uncover(m.column[j])
+		}
+		m.solution = m.solution[:len(m.solution)-1]
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m *dlxMatrix) cover(c int) {
+	m.right[m.left[c]], m.left[m.right[c]] = m.right[c], m.left[c]
+	for i := m.down[c]; i != c; i = m.down[i] {
+		for j := m.right[i]; j != i; j = m.right[j] {
+			m.down[m.up[j]], m.up[m.down[j]] = m.down[j], m.up[j]
+			m.size[m.column[j]]--
+		}
+	}
+}
+
+func (m *dlxMatrix) uncover(c int) {
+	for i := m.up[c]; i != c; i = m.up[i] {
+		for j := m.left[i]; j != i; j = m.left[j] {
+			m.size[m.column[j]]++
+			m.down[m.up[j]], m.up[m.down[j]] = j, j
+		}
+	}
+	m.right[m.left[c]], m.left[m.right[c]] = c, c
+}
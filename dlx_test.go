@@ -0,0 +1,80 @@
+package sudoku
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestDancingLinks_Search(t *testing.T) {
+	g := hardGame()
+	err := g.SolveContext(context.Background(), WithSolver(DancingLinks{}))
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	s := hardGameSolved()
+	if !reflect.DeepEqual(g.Board(), s.Board()) {
+		t.Errorf("sudoku solved expected:\n%s, got:\n%s", s, g)
+	}
+
+	for _, p := range hardestPuzzles {
+		testSolveHardest(t, p.name, gameFromString(p.puzzle), WithSolver(DancingLinks{}))
+	}
+
+	err = noSolutionGame().SolveContext(context.Background(), WithSolver(DancingLinks{}))
+	if err != ErrNoSolution {
+		t.Errorf("no solution error expected, got: %v", err)
+	}
+
+	err = hardGame().SolveContext(context.Background(), WithSolver(DancingLinks{MaxNodes: 10}))
+	if err != ErrSearchLimit {
+		t.Errorf("search limit error expected, got: %v", err)
+	}
+}
+
+func TestDancingLinks_SearchAll(t *testing.T) {
+	g := ambiguousGame()
+	expected, err := g.CountSolutions(1000)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	seen := make(map[Grid]bool)
+	_, err = DancingLinks{}.Search(context.Background(), g.(*Board).grid(), func(s Grid) bool {
+		b, err := s.board()
+		if err != nil || b.emptyValueIndex() >= 0 {
+			t.Errorf("solution should be valid and complete, got: %v", s)
+		}
+
+		seen[s] = true
+		return true
+	})
+
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if len(seen) != expected {
+		t.Errorf("%d distinct solutions expected, got: %d", expected, len(seen))
+	}
+
+	var invalid Grid
+	invalid[0], invalid[1] = 1, 1
+	_, err = DancingLinks{}.Search(context.Background(), invalid, func(Grid) bool { return true })
+	if err == nil {
+		t.Error("error expected for the invalid grid")
+	}
+}
+
+func BenchmarkDancingLinks_SolveHard(b *testing.B) {
+	benchmarkSolve(b, hardGame(), WithSolver(DancingLinks{}))
+}
+
+func BenchmarkDancingLinks_SolveHardest(b *testing.B) {
+	for _, p := range hardestPuzzles {
+		b.Run(p.name, func(b *testing.B) {
+			benchmarkSolve(b, gameFromString(p.puzzle), WithSolver(DancingLinks{}))
+		})
+	}
+}
@@ -6,8 +6,48 @@
 // (the original values before the user input any other guesses). A clue can be set by a single value, row, column or
 // entirely whole board at once.
 //
-// Sudoku can be solved by calling the method `Solve` on created Sudoku instance. Moreover, the whole sudoku can be
-// printed in any state, because implements the `Stringer` interface
+// Sudoku can be solved by calling the method `Solve` on created Sudoku instance. The method returns `ErrNoSolution`
+// when the board cannot be completed and `ErrWrongInput` when the board itself is invalid. Moreover, the whole sudoku
+// can be printed in any state, because implements the `Stringer` interface
+//
+// Whether the puzzle is proper (it has exactly one solution) can be checked by the method `HasUniqueSolution`.
+// The method `CountSolutions` counts the solutions up to the given limit. The method `IsMinimal` checks that none of the
+// clues can be removed without losing the uniqueness and `RedundantClues` lists the clues that can be.
+//
+// The solving engine is hidden behind the `Solver` interface. The default one is the `Backtracking`, however it can be
+// replaced by the `DancingLinks` (Knuth's Algorithm X) or any custom implementation either through the option
+// `WithSolver` of the method `SolveContext` or by setting the `DefaultSolver`.
+//
+// Besides the search, the method `SolveLogically` solves the board by named human techniques only (singles, locked
+// candidates, subsets, fish, wings and simple colouring) and returns the ordered steps it has made. The method
+// `NextHint` returns just the simplest deduction available together with the cells explaining it, and `ApplyHint`
+// applies it to the board.
+//
+// The function `Rate` classifies a puzzle with exactly one solution as Easy, Medium, Hard, Expert or Diabolical and gives
+// its score on the Sudoku Explainer scale together with the number of uses of each technique.
+//
+// The function `Generate` creates a new puzzle with the unique solution, either minimal or with the requested number of
+// clues. The seed of its `math/rand` source makes the puzzle reproducible, and the difficulty level or the score range asks
+// for the puzzle in the given difficulty band. The option `Symmetry` keeps the givens in the rotational, mirror, diagonal
+// or dihedral layout. The function `GenerateFromMask` places the givens exactly on the cells of a drawn mask.
+//
+// The function `Parse` reads the puzzle from the common 81 character line, where `.`, `0`, `_` or `*` is an empty cell,
+// and whitespace or the grid decoration `|`, `-` and `+` is ignored. The method `Compact` writes the board back to the
+// same line, `CompactWith` with the chosen empty cell character, and the board implements `encoding.TextMarshaler` and
+// `encoding.TextUnmarshaler` by this format.
+// Large datasets with one puzzle per line, optionally followed by the solution or the rating, are streamed by `Reader`
+// and written by `Writer`, where `ParseError` carries the line of the problem.
+//
+// The board tells the givens of the puzzle from the values entered by the player (`SetEntry`, `IsGiven`) and keeps the
+// pencil marks of empty cells (`SetCandidates`, `Candidates`), which `NextHint` takes into account. The JSON form of the
+// board keeps all of them: `{"variant": "standard", "givens": "..9748...", "entries": "1........", "candidates": [[], [2, 5], ...]}`,
+// where givens and entries are the 81 character lines and the optional candidates are 81 lists ordered row by row.
+//
+// The subpackage `format` reads and writes the files of SadMan Software Sudoku (.sdk) with its metadata header, Simple
+// Sudoku (.ss) and the HoDoKu library with player entries and pencil marks. Its errors carry the line and the column.
+//
+// The method `Render` draws the board with Unicode or ASCII borders and thick lines between boxes, optionally with row
+// and column labels and pencil marks shown as mini 3x3 grids inside cells. The method `String` keeps its simple layout.
 //
 // Example of basic usage:
 //
@@ -37,7 +77,11 @@
 //				log.Fatal(err)
 //			}
 //
-//			game.Solve()
+//			err = game.Solve()
+//			if err != nil {
+//				log.Fatal(err)
+//			}
+//
 //			fmt.Println(game)
 //		}
 package sudoku
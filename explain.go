@@ -0,0 +1,301 @@
+package sudoku
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Notation is the way how cells and houses are referenced in explanations.
+type Notation int
+
+// Notations of cells.
+const (
+	ZeroBasedNotation Notation = iota // 0-based coordinates used by SetValue and Value, e.g. (3, 5)
+	R1C1Notation                      // 1-based row and column, e.g. r4c6
+)
+
+// Catalog keeps the messages of one language. Templates can contain placeholders replaced by the step details:
+//
+//	{technique}     name of the technique
+//	{house}         the first house of the step, {House} is the same with the first letter in upper case
+//	{other}         the second house of the step
+//	{others}        all houses of the step but the first
+//	{houses}        all houses of the step
+//	{digit}         the first digit of the step
+//	{digits}        all digits of the step
+//	{cell}          the first cell of the step
+//	{cells}         all cells of the step
+//	{wings}         all cells of the step but the first, such as the wing cells following the pivot
+//	{placements}    placed values rendered by the Placement template
+//	{eliminations}  removed candidates rendered by the Elimination template
+//	{targets}       cells with removed candidates
+//	{removed}       removed digits
+//	{reason}        the Because clause of the technique, when the step has the houses ruling out the rest of the
+//	                single: the houses following the first one of the hidden single, any house of the naked single
+type Catalog struct {
+	Steps         map[Technique]string // sentence of each technique, the Fallback is used for missing ones
+	Fallback      string               // sentence of a technique without its own template
+	Reasons       string               // sentence added to hints with reason cells, only {cells} is replaced
+	Because       map[Technique]string // clauses of {reason}, any placeholder but {reason} is replaced
+	Techniques    map[Technique]string // names of techniques, the String method of Technique is used for missing ones
+	Houses        map[HouseKind]string // names of houses
+	Placement     string               // one placed value, only {digit} and {cell} are replaced
+	Elimination   string               // one removed candidate, only {digit} and {cell} are replaced
+	Separator     string               // separator of list items
+	LastSeparator string               // separator of the last two list items
+}
+
+// English is the default catalog.
+var English = Catalog{
+	Steps: map[Technique]string{
+		HiddenSingle:             "{House} needs a {digit}; the only cell in {house} that can hold {digit} is {cell}{reason}.",
+		NakedSingle:              "{cell} can only hold {digit}{reason}.",
+		LockedCandidatesPointing: "In {house} the {digit} can only be in {other}, so {digit} can be removed from {targets}.",
+		LockedCandidatesClaiming: "In {house} the {digit} can only be in {other}, so {digit} can be removed from {targets}.",
+		NakedPair:                "{cells} can only hold {digits}, so these digits can be removed from the rest of {house}: {eliminations}.",
+		NakedTriple:              "{cells} can only hold {digits}, so these digits can be removed from the rest of {house}: {eliminations}.",
+		NakedQuad:                "{cells} can only hold {digits}, so these digits can be removed from the rest of {house}: {eliminations}.",
+		HiddenPair:               "In {house} the digits {digits} fit only into {cells}, so the other candidates can be removed: {eliminations}.",
+		HiddenTriple:             "In {house} the digits {digits} fit only into {cells}, so the other candidates can be removed: {eliminations}.",
+		HiddenQuad:               "In {house} the digits {digits} fit only into {cells}, so the other candidates can be removed: {eliminations}.",
+		XWing:                    "The {digit} in {cells} forms the {technique} on {houses}, so {digit} can be removed from {targets}.",
+		Swordfish:                "The {digit} in {cells} forms the {technique} on {houses}, so {digit} can be removed from {targets}.",
+		Jellyfish:                "The {digit} in {cells} forms the {technique} on {houses}, so {digit} can be removed from {targets}.",
+		XYWing:                   "Whichever value the pivot {cell} takes, one of the wing cells {wings} holds {removed}, so {removed} can be removed from {targets}.",
+		XYZWing:                  "Whichever value the pivot {cell} takes, one of the wing cells {wings} holds {removed}, so {removed} can be removed from {targets}.",
+		SimpleColouring:          "Colouring the conjugate pairs of {digit} in {cells} shows that {digit} can be removed from {targets}.",
+	},
+	Fallback: "The {technique} in {cells} places {placements} and removes {eliminations}.",
+	Reasons:  "It follows from {cells}.",
+	Because: map[Technique]string{
+		HiddenSingle: " because {digit} is already in {others}",
+		NakedSingle:  "; all other digits are already in {houses}",
+	},
+	Houses: map[HouseKind]string{
+		RowHouse:    "row",
+		ColumnHouse: "column",
+		BoxHouse:    "box",
+	},
+	Placement:     "{digit} in {cell}",
+	Elimination:   "{digit} from {cell}",
+	Separator:     ", ",
+	LastSeparator: " and ",
+}
+
+// Explainer renders the deductions of the logical solver as sentences. The zero value uses the English catalog with
+// 0-based coordinates.
+type Explainer struct {
+	Catalog  *Catalog // messages of the language, nil means English
+	Notation Notation // way of referencing cells and houses
+}
+
+// Explain method returns the sentence describing the step.
+func (e Explainer) Explain(s Step) string {
+	c := e.catalog()
+	template, ok := c.Steps[s.Technique]
+	if !ok {
+		template = c.Fallback
+	}
+
+	return e.render(template, s)
+}
+
+// ExplainHint method returns the sentence describing the hint followed by the sentence with its reason cells.
+func (e Explainer) ExplainHint(h Hint) string {
+	sentence := e.Explain(h.Step)
+	if len(h.Reasons) == 0 {
+		return sentence
+	}
+
+	return sentence + " " + strings.Replace(e.catalog().Reasons, "{cells}", e.cells(h.Reasons), -1)
+}
+
+// String method returns the English explanation of the step with 0-based coordinates.
+func (s Step) String() string {
+	return Explainer{}.Explain(s)
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+func (e Explainer) catalog() *Catalog {
+	if e.Catalog == nil {
+		return &English
+	}
+
+	return e.Catalog
+}
+
+func (e Explainer) render(template string, s Step) string {
+	c := e.catalog()
+
+	houses := make([]string, len(s.Houses))
+	for i, h := range s.Houses {
+		houses[i] = e.house(h)
+	}
+
+	digits := make([]string, len(s.Digits))
+	for i, d := range s.Digits {
+		digits[i] = strconv.Itoa(d)
+	}
+
+	placements := make([]string, len(s.Placements))
+	for i, p := range s.Placements {
+		placements[i] = e.candidate(c.Placement, p)
+	}
+
+	eliminations := make([]string, len(s.Eliminations))
+	var targets []Cell
+	var removed []string
+	for i, el := range s.Eliminations {
+		eliminations[i] = e.candidate(c.Elimination, el)
+		if !containsCell(targets, el.Cell) {
+			targets = append(targets, el.Cell)
+		}
+		if d := strconv.Itoa(el.Value); !containsString(removed, d) {
+			removed = append(removed, d)
+		}
+	}
+
+	house, other, digit, cell := "", "", "", ""
+	var others []string
+	var wings []Cell
+	if len(houses) > 0 {
+		house = houses[0]
+		others = houses[1:]
+	}
+	if len(houses) > 1 {
+		other = houses[1]
+	}
+	if len(digits) > 0 {
+		digit = digits[0]
+	}
+	if len(s.Cells) > 0 {
+		cell = e.cell(s.Cells[0])
+		wings = s.Cells[1:]
+	}
+
+	r := strings.NewReplacer(
+		"{technique}", e.technique(s.Technique),
+		"{house}", house,
+		"{House}", capitalize(house),
+		"{other}", other,
+		"{others}", e.join(others),
+		"{houses}", e.join(houses),
+		"{digit}", digit,
+		"{digits}", e.join(digits),
+		"{cell}", cell,
+		"{cells}", e.cells(s.Cells),
+		"{wings}", e.cells(wings),
+		"{placements}", e.join(placements),
+		"{eliminations}", e.join(eliminations),
+		"{targets}", e.cells(targets),
+		"{removed}", e.join(removed),
+	)
+
+	reason := ""
+	if explained(s) {
+		reason = r.Replace(c.Because[s.Technique])
+	}
+
+	return strings.NewReplacer("{reason}", reason).Replace(r.Replace(template))
+}
+
+// explained checks if the single carries the houses ruling out the rest of it, which follow the unit of the hidden
+// single, while the naked single has no other houses.
+func explained(s Step) bool {
+	switch s.Technique {
+	case HiddenSingle:
+		return len(s.Houses) > 1
+	case NakedSingle:
+		return len(s.Houses) > 0
+	default:
+		return false
+	}
+}
+
+func (e Explainer) technique(t Technique) string {
+	if name, ok := e.catalog().Techniques[t]; ok {
+		return name
+	}
+
+	return t.String()
+}
+
+func (e Explainer) house(h House) string {
+	name, ok := e.catalog().Houses[h.Kind]
+	if !ok {
+		name = h.Kind.String()
+	}
+
+	if e.Notation == R1C1Notation {
+		return fmt.Sprintf("%s %d", name, h.Index+1)
+	}
+
+	return fmt.Sprintf("%s %d", name, h.Index)
+}
+
+func (e Explainer) cell(c Cell) string {
+	if e.Notation == R1C1Notation {
+		return fmt.Sprintf("r%dc%d", c.Row+1, c.Column+1)
+	}
+
+	return fmt.Sprintf("(%d, %d)", c.Row, c.Column)
+}
+
+func (e Explainer) cells(cells []Cell) string {
+	names := make([]string, len(cells))
+	for i, c := range cells {
+		names[i] = e.cell(c)
+	}
+
+	return e.join(names)
+}
+
+func (e Explainer) candidate(template string, c Candidate) string {
+	return strings.NewReplacer(
+		"{digit}", strconv.Itoa(c.Value),
+		"{cell}", e.cell(c.Cell),
+	).Replace(template)
+}
+
+// join puts the items into one list, where the last two items are joined by the last separator.
+func (e Explainer) join(items []string) string {
+	c := e.catalog()
+	if len(items) < 2 {
+		return strings.Join(items, "")
+	}
+
+	return strings.Join(items[:len(items)-1], c.Separator) + c.LastSeparator + items[len(items)-1]
+}
+
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if size == 0 {
+		return s
+	}
+
+	return string(unicode.ToUpper(r)) + s[size:]
+}
+
+func containsCell(cells []Cell, c Cell) bool {
+	for _, x := range cells {
+		if x == c {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsString(items []string, s string) bool {
+	for _, x := range items {
+		if x == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,160 @@
+package sudoku
+
+import (
+	"strings"
+	"testing"
+)
+
+func hiddenSingleStep() Step {
+	return Step{
+		Technique:  HiddenSingle,
+		Houses:     []House{{Kind: RowHouse, Index: 3}},
+		Digits:     []int{7},
+		Cells:      []Cell{{Row: 3, Column: 5}},
+		Placements: []Candidate{{Cell: Cell{Row: 3, Column: 5}, Value: 7}},
+	}
+}
+
+func TestExplainer_Explain(t *testing.T) {
+	s := hiddenSingleStep()
+
+	expected := "Row 3 needs a 7; the only cell in row 3 that can hold 7 is (3, 5)."
+	if got := s.String(); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+
+	expected = "Row 4 needs a 7; the only cell in row 4 that can hold 7 is r4c6."
+	if got := (Explainer{Notation: R1C1Notation}).Explain(s); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+
+	s = Step{
+		Technique: LockedCandidatesPointing,
+		Houses:    []House{{Kind: BoxHouse, Index: 0}, {Kind: RowHouse, Index: 1}},
+		Digits:    []int{5},
+		Cells:     []Cell{{Row: 1, Column: 0}, {Row: 1, Column: 2}},
+		Eliminations: []Candidate{
+			{Cell: Cell{Row: 1, Column: 4}, Value: 5},
+			{Cell: Cell{Row: 1, Column: 7}, Value: 5},
+		},
+	}
+
+	expected = "In box 1 the 5 can only be in row 2, so 5 can be removed from r2c5 and r2c8."
+	if got := (Explainer{Notation: R1C1Notation}).Explain(s); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestExplainer_Reason(t *testing.T) {
+	s := hiddenSingleStep()
+	s.Houses = append(s.Houses, House{Kind: ColumnHouse, Index: 0}, House{Kind: BoxHouse, Index: 4})
+
+	expected := "Row 4 needs a 7; the only cell in row 4 that can hold 7 is r4c6 because 7 is already in column 1 and box 5."
+	if got := (Explainer{Notation: R1C1Notation}).Explain(s); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+
+	s = Step{
+		Technique:    XYWing,
+		Digits:       []int{1, 2, 3},
+		Cells:        []Cell{{Row: 0, Column: 0}, {Row: 0, Column: 4}, {Row: 4, Column: 0}},
+		Eliminations: []Candidate{{Cell: Cell{Row: 4, Column: 4}, Value: 3}},
+	}
+
+	expected = "Whichever value the pivot r1c1 takes, one of the wing cells r1c5 and r5c1 holds 3, so 3 can be removed from r5c5."
+	if got := (Explainer{Notation: R1C1Notation}).Explain(s); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestExplainer_NakedSingle(t *testing.T) {
+	// the single of the hard game left by eliminations, not by the values of its row, column and box
+	steps, err := hardGame().SolveLogically()
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	var s Step
+	for _, step := range steps {
+		if step.Technique == NakedSingle {
+			s = step
+			break
+		}
+	}
+
+	if s.Technique != NakedSingle || len(s.Houses) != 0 {
+		t.Fatalf("naked single by eliminations expected, got: %+v", s)
+	}
+
+	expected := "(5, 4) can only hold 2."
+	if got := s.String(); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+
+	s.Houses = []House{{Kind: RowHouse, Index: 5}, {Kind: BoxHouse, Index: 4}}
+	expected = "(5, 4) can only hold 2; all other digits are already in row 5 and box 4."
+	if got := s.String(); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestExplainer_Catalog(t *testing.T) {
+	czech := Catalog{
+		Steps: map[Technique]string{
+			HiddenSingle: "{House} potřebuje {digit}, jediné volné místo je {cell}.",
+		},
+		Fallback: "{technique}: {placements}.",
+		Techniques: map[Technique]string{
+			NakedSingle: "Jediný kandidát",
+		},
+		Houses: map[HouseKind]string{
+			RowHouse: "řádek",
+		},
+		Placement: "{digit} v {cell}",
+	}
+
+	e := Explainer{Catalog: &czech, Notation: R1C1Notation}
+	expected := "Řádek 4 potřebuje 7, jediné volné místo je r4c6."
+	if got := e.Explain(hiddenSingleStep()); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+
+	s := hiddenSingleStep()
+	s.Technique = NakedSingle
+	expected = "Jediný kandidát: 7 v r4c6."
+	if got := e.Explain(s); got != expected {
+		t.Errorf("fallback explanation expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestExplainer_ExplainHint(t *testing.T) {
+	h := Hint{
+		Step:    hiddenSingleStep(),
+		Reasons: []Cell{{Row: 0, Column: 1}, {Row: 5, Column: 2}},
+	}
+
+	expected := "Row 3 needs a 7; the only cell in row 3 that can hold 7 is (3, 5). It follows from (0, 1) and (5, 2)."
+	if got := (Explainer{}).ExplainHint(h); got != expected {
+		t.Errorf("explanation expected: %q, got: %q", expected, got)
+	}
+}
+
+func TestExplainer_AllSteps(t *testing.T) {
+	for _, g := range []Game{easyGame(), hardGame()} {
+		steps, err := g.SolveLogically()
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		for _, s := range steps {
+			sentence := (Explainer{Notation: R1C1Notation}).Explain(s)
+			if sentence == "" || strings.ContainsAny(sentence, "{}") {
+				t.Errorf("%v: explanation expected without placeholders, got: %q", s.Technique, sentence)
+			}
+
+			if s.Technique == HiddenSingle && len(s.Houses) > 1 && !strings.Contains(sentence, " because ") {
+				t.Errorf("hidden single with the reason expected, got: %q", sentence)
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+// Package format reads and writes the Sudoku files of the community tools: SadMan Software Sudoku (.sdk), Simple
+// Sudoku (.ss) and the puzzle library of HoDoKu. Every reader returns *Error with the line and the column of the
+// problem.
+package format
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lukasaron/sudoku"
+)
+
+// Error describes the position and the reason why the file cannot be read. Line and column start at 1, zero column
+// means the problem of the whole line.
+type Error struct {
+	Line   int    // line of the problem
+	Column int    // column of the problem in characters
+	Reason string // description of the problem
+}
+
+// Error method returns the description of the error with its position.
+func (e *Error) Error() string {
+	if e.Column == 0 {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Reason)
+	}
+
+	return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Reason)
+}
+
+// Metadata keeps the header of the file, where keys are the field codes of the format.
+type Metadata map[string]string
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// scanner reads the input line by line and counts the lines.
+type scanner struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func newScanner(r io.Reader) *scanner {
+	return &scanner{s: bufio.NewScanner(r)}
+}
+
+func (s *scanner) scan() bool {
+	if !s.s.Scan() {
+		return false
+	}
+
+	s.line++
+	return true
+}
+
+func (s *scanner) text() string {
+	return strings.TrimRight(s.s.Text(), "\r")
+}
+
+// rowReader reads one row of the grid, where decoration characters are skipped.
+type rowReader struct {
+	blank      func(rune) bool
+	decoration func(rune) bool
+}
+
+func (rr rowReader) read(text string, line int) ([]int, error) {
+	row := make([]int, 0, sudoku.BoardSide)
+	column := 0
+	for _, r := range text {
+		column++
+
+		var v int
+		switch {
+		case r >= '1' && r <= '9':
+			v = int(r - '0')
+		case rr.blank(r):
+		case rr.decoration(r):
+			continue
+		default:
+			return nil, &Error{Line: line, Column: column, Reason: fmt.Sprintf("unexpected character %q", r)}
+		}
+
+		if len(row) == sudoku.BoardSide {
+			return nil, &Error{Line: line, Column: column, Reason: "too many cells in the row"}
+		}
+		row = append(row, v)
+	}
+
+	if len(row) < sudoku.BoardSide {
+		return nil, &Error{Line: line, Column: column + 1, Reason: fmt.Sprintf("too few cells in the row, got %d", len(row))}
+	}
+
+	return row, nil
+}
+
+// newGame creates the game of the rows, the line is the position of the first row.
+func newGame(rows [][]int, line int) (sudoku.Game, error) {
+	g := sudoku.NewBoard().SetBoard(rows)
+	if err := g.Error(); err != nil {
+		return nil, &Error{Line: line, Reason: "invalid board: " + err.Error()}
+	}
+
+	return g, nil
+}
+
+// givens returns the rows of the game with givens only, the values entered by the player are left out.
+func givens(g sudoku.Game) ([][]int, error) {
+	if err := g.Error(); err != nil {
+		return nil, err
+	}
+
+	rows := g.Board()
+	for r, row := range rows {
+		for c := range row {
+			if !g.IsGiven(r, c) {
+				row[c] = 0
+			}
+		}
+	}
+
+	return rows, nil
+}
+
+// possible returns the digits, which are not placed in any house of the cell.
+func possible(g sudoku.Game, row, column int) [sudoku.MaxValue + 1]bool {
+	var p [sudoku.MaxValue + 1]bool
+	for d := 1; d <= sudoku.MaxValue; d++ {
+		p[d] = true
+	}
+
+	box := row/sudoku.BoardBoxSize*sudoku.BoardBoxSize + column/sudoku.BoardBoxSize
+	for _, house := range [][]int{g.Row(row), g.Column(column), g.Box(box)} {
+		for _, v := range house {
+			p[v] = false
+		}
+	}
+
+	return p
+}
+
+func writeRow(sb *strings.Builder, row []int, blank byte, separator string) {
+	for c, v := range row {
+		if c > 0 && c%sudoku.BoardBoxSize == 0 {
+			sb.WriteString(separator)
+		}
+
+		if v == 0 {
+			sb.WriteByte(blank)
+			continue
+		}
+		sb.WriteByte(byte('0' + v))
+	}
+	sb.WriteByte('\n')
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
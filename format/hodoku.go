@@ -0,0 +1,208 @@
+package format
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/lukasaron/sudoku"
+)
+
+// Fields of the HoDoKu library line, the line starts by the separator, hence the first field is empty.
+const (
+	hodokuPuzzle  = 3 // 81 cells, where '+' marks the value entered by the player
+	hodokuDeleted = 4 // deleted candidates as "digit row column" triples separated by spaces
+	hodokuFields  = 6 // minimal number of fields
+)
+
+// ReadHoDoKu reads the puzzles of the HoDoKu library, one puzzle per line:
+//
+//	:0000:x:7.2.34.8.........2.8..51.74.......51..62.47..73.......24.3..6.5.........2.63.7.3:211 611 711::
+//
+// The fields are the technique code, its candidate, the puzzle, the deleted candidates, the eliminations and the
+// placements of the technique. Only the puzzle and the deleted candidates are read: the digits of the puzzle marked by
+// '+' become the player entries and the cells with deleted candidates get pencil marks of the remaining ones, which
+// cannot be all deleted. Empty lines and lines starting by '#' are skipped.
+func ReadHoDoKu(r io.Reader) ([]sudoku.Game, error) {
+	s := newScanner(r)
+	var games []sudoku.Game
+	for s.scan() {
+		text := s.text()
+		if strings.TrimSpace(text) == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		g, err := readHoDoKuLine(text, s.line)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, g)
+	}
+
+	if err := s.s.Err(); err != nil {
+		return nil, err
+	}
+
+	return games, nil
+}
+
+// WriteHoDoKu writes the games as the HoDoKu library, one line per game. The values entered by the player are marked
+// by '+' and the pencil marks are written as the deleted candidates.
+func WriteHoDoKu(w io.Writer, games ...sudoku.Game) error {
+	sb := strings.Builder{}
+	for _, g := range games {
+		if err := g.Error(); err != nil {
+			return err
+		}
+
+		var deleted []string
+		sb.WriteString(":0000:x:")
+		for r := 0; r < sudoku.BoardSide; r++ {
+			for c := 0; c < sudoku.BoardSide; c++ {
+				v := g.Value(r, c)
+				switch {
+				case v == 0:
+					sb.WriteByte('.')
+					deleted = append(deleted, deletedCandidates(g, r, c)...)
+				case g.IsGiven(r, c):
+					sb.WriteByte(byte('0' + v))
+				default:
+					sb.WriteByte('+')
+					sb.WriteByte(byte('0' + v))
+				}
+			}
+		}
+
+		sb.WriteString(":" + strings.Join(deleted, " ") + "::\n")
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+func readHoDoKuLine(text string, line int) (sudoku.Game, error) {
+	fields := strings.Split(text, ":")
+	if fields[0] != "" || len(fields) < hodokuFields {
+		return nil, &Error{Line: line, Reason: "library line expected, such as :0000:x:<puzzle>:<deleted>::"}
+	}
+
+	// offsets of the fields in the line
+	offsets := make([]int, len(fields))
+	for i := 1; i < len(fields); i++ {
+		offsets[i] = offsets[i-1] + len(fields[i-1]) + 1
+	}
+
+	column := func(offset int) int {
+		return utf8.RuneCountInString(text[:offset]) + 1
+	}
+
+	g := sudoku.NewBoard()
+	puzzle, idx, entry := fields[hodokuPuzzle], 0, false
+	for i, r := range puzzle {
+		if r == '+' && !entry {
+			entry = true
+			continue
+		}
+
+		pos := column(offsets[hodokuPuzzle] + i)
+		if idx == sudoku.BoardSize {
+			return nil, &Error{Line: line, Column: pos, Reason: "too many cells"}
+		}
+
+		row, col := idx/sudoku.BoardSide, idx%sudoku.BoardSide
+		switch {
+		case r >= '1' && r <= '9' && entry:
+			g.SetEntry(row, col, int(r-'0'))
+		case r >= '1' && r <= '9':
+			g.SetValue(row, col, int(r-'0'))
+		case (r == '.' || r == '0') && !entry:
+		default:
+			return nil, &Error{Line: line, Column: pos, Reason: fmt.Sprintf("unexpected character %q", r)}
+		}
+
+		idx++
+		entry = false
+	}
+
+	if idx < sudoku.BoardSize || entry {
+		pos := column(offsets[hodokuPuzzle] + len(puzzle))
+		return nil, &Error{Line: line, Column: pos, Reason: fmt.Sprintf("too few cells, got %d", idx)}
+	}
+
+	if !g.IsValid() {
+		return nil, &Error{Line: line, Column: column(offsets[hodokuPuzzle]), Reason: "invalid board"}
+	}
+
+	// pencil marks are the possible digits without the deleted ones
+	var deleted [sudoku.BoardSize][sudoku.MaxValue + 1]bool
+	var first [sudoku.BoardSize]int // column of the first deleted candidate of the cell
+	offset := offsets[hodokuDeleted]
+	for _, token := range strings.Split(fields[hodokuDeleted], " ") {
+		pos := column(offset)
+		offset += len(token) + 1
+		if token == "" {
+			continue
+		}
+
+		if len(token) != 3 || strings.Trim(token, "123456789") != "" {
+			return nil, &Error{Line: line, Column: pos, Reason: fmt.Sprintf("candidate expected as digit, row and column, got %q", token)}
+		}
+
+		d, row, col := int(token[0]-'0'), int(token[1]-'1'), int(token[2]-'1')
+		if !g.IsEmpty(row, col) {
+			return nil, &Error{Line: line, Column: pos, Reason: fmt.Sprintf("candidate %s of the filled cell", token)}
+		}
+		if first[row*sudoku.BoardSide+col] == 0 {
+			first[row*sudoku.BoardSide+col] = pos
+		}
+		deleted[row*sudoku.BoardSide+col][d] = true
+	}
+
+	for idx, digits := range deleted {
+		row, col := idx/sudoku.BoardSide, idx%sudoku.BoardSide
+		if digits == ([sudoku.MaxValue + 1]bool{}) {
+			continue
+		}
+
+		var marks []int
+		p := possible(g, row, col)
+		for d := 1; d <= sudoku.MaxValue; d++ {
+			if p[d] && !digits[d] {
+				marks = append(marks, d)
+			}
+		}
+
+		if len(marks) == 0 {
+			return nil, &Error{Line: line, Column: first[idx], Reason: "no candidates left"}
+		}
+		g.SetCandidates(row, col, marks)
+	}
+
+	return g, nil
+}
+
+// deletedCandidates returns the possible digits of the cell, which are not in its pencil marks, as HoDoKu triples.
+func deletedCandidates(g sudoku.Game, row, column int) []string {
+	marks := g.Candidates(row, column)
+	if len(marks) == 0 {
+		return nil
+	}
+
+	var kept [sudoku.MaxValue + 1]bool
+	for _, m := range marks {
+		kept[m] = true
+	}
+
+	var deleted []string
+	p := possible(g, row, column)
+	for d := 1; d <= sudoku.MaxValue; d++ {
+		if p[d] && !kept[d] {
+			deleted = append(deleted, fmt.Sprintf("%d%d%d", d, row+1, column+1))
+		}
+	}
+
+	return deleted
+}
@@ -0,0 +1,96 @@
+package format
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// the digit 1 is deleted from the first column of rows 1, 4 and 6
+const hodokuLine = ":0000:x:" + puzzle + ":111 141 161::"
+
+func TestReadHoDoKu(t *testing.T) {
+	games, err := ReadHoDoKu(strings.NewReader("# library\n" + hodokuLine + "\n\n:0000:x:+1" + puzzle[1:] + ":::\n"))
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if len(games) != 2 {
+		t.Fatalf("two games expected, got: %d", len(games))
+	}
+
+	g := games[0]
+	if g.Compact() != puzzle || !g.IsGiven(0, 2) {
+		t.Errorf("puzzle expected, got:\n%s", g)
+	}
+
+	candidates := map[[2]int][]int{{0, 0}: {3, 5, 6}, {3, 0}: {3, 5}, {5, 0}: {2, 5}, {0, 1}: {}}
+	for cell, expected := range candidates {
+		if c := g.Candidates(cell[0], cell[1]); !reflect.DeepEqual(c, expected) {
+			t.Errorf("pencil marks %v expected in %v, got: %v", expected, cell, c)
+		}
+	}
+
+	if g = games[1]; g.Value(0, 0) != 1 || g.IsGiven(0, 0) {
+		t.Errorf("entry 1 expected, got:\n%s", g)
+	}
+}
+
+func TestReadHoDoKu_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		column int
+	}{
+		{"fields", "0000:x:" + puzzle, 0},
+		{"character", strings.Replace(hodokuLine, "..9748", ".a9748", 1), 10},
+		{"entry", strings.Replace(hodokuLine, "..9748", "+..9748", 1), 10},
+		{"long puzzle", strings.Replace(hodokuLine, puzzle, puzzle+".", 1), 90},
+		{"short puzzle", strings.Replace(hodokuLine, puzzle, puzzle[:80], 1), 89},
+		{"conflict", strings.Replace(hodokuLine, "..9748", "9.9748", 1), 9},
+		{"candidate", strings.Replace(hodokuLine, "141", "14", 1), 95},
+		{"filled", strings.Replace(hodokuLine, "161", "161 113", 1), 103},
+		{"no candidates", strings.Replace(hodokuLine, "161", "161 311 511 611", 1), 91},
+	}
+
+	for _, tc := range tests {
+		_, err := ReadHoDoKu(strings.NewReader("\n" + tc.input))
+		e, ok := err.(*Error)
+		if !ok {
+			t.Fatalf("%s: format error expected, got: %v", tc.name, err)
+		}
+
+		if e.Line != 2 || e.Column != tc.column {
+			t.Errorf("%s: line 2 and column %d expected, got: %v", tc.name, tc.column, e)
+		}
+	}
+}
+
+func TestWriteHoDoKu(t *testing.T) {
+	games, err := ReadHoDoKu(strings.NewReader(hodokuLine))
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	g := games[0].SetEntry(1, 1, 4)
+	buf := bytes.Buffer{}
+	if err = WriteHoDoKu(&buf, g, g); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	line := ":0000:x:" + puzzle[:10] + "+4" + puzzle[11:] + ":111 141 161::\n"
+	if buf.String() != line+line {
+		t.Errorf("library lines expected:\n%s, got:\n%s", line+line, buf.String())
+	}
+
+	// the round trip keeps the givens, the entries and the pencil marks
+	read, err := ReadHoDoKu(&buf)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if len(read) != 2 || !reflect.DeepEqual(read[0], g) {
+		t.Errorf("the same game expected after round trip, got: %v", read)
+	}
+}
@@ -0,0 +1,99 @@
+package format
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/lukasaron/sudoku"
+)
+
+// sdkRow reads rows of SadMan files, where '.' or '0' is the empty cell.
+var sdkRow = rowReader{
+	blank:      func(r rune) bool { return r == '.' || r == '0' },
+	decoration: isSpace,
+}
+
+// ReadSDK reads the puzzle in the SadMan Software Sudoku format. The grid of nine lines can be preceded by the header,
+// where every line starts by '#' followed by the one letter code of the field, such as "#AAuthor" or "#DDescription".
+// The codes are the keys of the returned metadata. Empty lines are skipped.
+func ReadSDK(r io.Reader) (sudoku.Game, Metadata, error) {
+	s := newScanner(r)
+	meta := Metadata{}
+	var rows [][]int
+	first := 0
+	for s.scan() {
+		text := s.text()
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "#") {
+			if len(rows) > 0 {
+				return nil, nil, &Error{Line: s.line, Column: 1, Reason: "header after the grid"}
+			}
+
+			if len(text) > 1 {
+				meta[text[1:2]] = text[2:]
+			}
+			continue
+		}
+
+		if len(rows) == sudoku.BoardSide {
+			return nil, nil, &Error{Line: s.line, Column: 1, Reason: "unexpected line after the grid"}
+		}
+
+		row, err := sdkRow.read(text, s.line)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if len(rows) == 0 {
+			first = s.line
+		}
+		rows = append(rows, row)
+	}
+
+	if err := s.s.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(rows) < sudoku.BoardSide {
+		return nil, nil, &Error{Line: s.line + 1, Reason: fmt.Sprintf("too few rows, got %d", len(rows))}
+	}
+
+	g, err := newGame(rows, first)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return g, meta, nil
+}
+
+// WriteSDK writes the givens of the game in the SadMan Software Sudoku format, the header contains the metadata
+// ordered by codes.
+func WriteSDK(w io.Writer, g sudoku.Game, meta Metadata) error {
+	rows, err := givens(g)
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	sb := strings.Builder{}
+	for _, k := range keys {
+		sb.WriteString("#" + k + meta[k] + "\n")
+	}
+
+	for _, row := range rows {
+		writeRow(&sb, row, '.', "")
+	}
+
+	_, err = io.WriteString(w, sb.String())
+	return err
+}
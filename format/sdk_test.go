@@ -0,0 +1,105 @@
+package format
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lukasaron/sudoku"
+)
+
+const puzzle = "..9748...7.........2.1.9.....7...24..64.1.59..98...3.....8.3.2.........6...2759.."
+
+const sdkFile = `#AJohn Smith
+#DNewspaper puzzle
+..9748...
+7........
+.2.1.9...
+..7...24.
+.64.1.59.
+.98...3..
+...8.3.2.
+........6
+...2759..
+`
+
+func TestReadSDK(t *testing.T) {
+	g, meta, err := ReadSDK(strings.NewReader(strings.Replace(sdkFile, "\n", "\r\n", -1) + "\n"))
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if g.Compact() != puzzle {
+		t.Errorf("puzzle expected: %s, got: %s", puzzle, g.Compact())
+	}
+
+	if !reflect.DeepEqual(meta, Metadata{"A": "John Smith", "D": "Newspaper puzzle"}) {
+		t.Errorf("metadata expected, got: %v", meta)
+	}
+}
+
+func TestReadSDK_Errors(t *testing.T) {
+	lines := strings.Split(sdkFile, "\n")
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"character", strings.Replace(sdkFile, "7........", "7...x....", 1), 4, 5},
+		{"long row", strings.Replace(sdkFile, "7........", "7.........", 1), 4, 10},
+		{"short row", strings.Replace(sdkFile, "7........", "7.......", 1), 4, 9},
+		{"few rows", strings.Join(lines[:8], "\n"), 9, 0},
+		{"many rows", sdkFile + ".........\n", 12, 1},
+		{"header", sdkFile + "#Ccomment\n", 12, 1},
+		{"conflict", strings.Replace(sdkFile, "7........", "77.......", 1), 3, 0},
+	}
+
+	for _, tc := range tests {
+		_, _, err := ReadSDK(strings.NewReader(tc.input))
+		e, ok := err.(*Error)
+		if !ok {
+			t.Fatalf("%s: format error expected, got: %v", tc.name, err)
+		}
+
+		if e.Line != tc.line || e.Column != tc.column {
+			t.Errorf("%s: line %d and column %d expected, got: %v", tc.name, tc.line, tc.column, e)
+		}
+	}
+}
+
+func TestWriteSDK(t *testing.T) {
+	g, err := sudoku.Parse(puzzle)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	// entries of the player are not the part of the puzzle
+	g.SetEntry(0, 0, 1)
+
+	buf := bytes.Buffer{}
+	if err = WriteSDK(&buf, g, Metadata{"D": "Newspaper puzzle", "A": "John Smith"}); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if buf.String() != sdkFile {
+		t.Errorf("file expected:\n%s, got:\n%s", sdkFile, buf.String())
+	}
+
+	if err = WriteSDK(&buf, sudoku.NewBoard().SetValue(sudoku.BoardSide, 0, 1), nil); err == nil {
+		t.Error("state error expected")
+	}
+}
+
+func TestError_Error(t *testing.T) {
+	e := &Error{Line: 3, Column: 5, Reason: "unexpected character 'x'"}
+	if e.Error() != "line 3, column 5: unexpected character 'x'" {
+		t.Errorf("error message expected, got: %s", e)
+	}
+
+	e.Column = 0
+	if e.Error() != "line 3: unexpected character 'x'" {
+		t.Errorf("error message without column expected, got: %s", e)
+	}
+}
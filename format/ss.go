@@ -0,0 +1,79 @@
+package format
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/lukasaron/sudoku"
+)
+
+// ssRow reads rows of Simple Sudoku files, where '.', 'X' or '0' is the empty cell and '|' separates boxes.
+var ssRow = rowReader{
+	blank:      func(r rune) bool { return r == '.' || r == 'X' || r == 'x' || r == '0' },
+	decoration: func(r rune) bool { return isSpace(r) || r == '|' },
+}
+
+// ReadSS reads the puzzle in the Simple Sudoku format. Boxes of the grid are separated by '|' in rows and by lines of
+// '-' between them, where the border drawn by '*' and '-' is accepted as well. Empty lines are skipped.
+func ReadSS(r io.Reader) (sudoku.Game, error) {
+	s := newScanner(r)
+	var rows [][]int
+	first := 0
+	for s.scan() {
+		text := s.text()
+		if strings.TrimSpace(text) == "" || isSSSeparator(text) {
+			continue
+		}
+
+		if len(rows) == sudoku.BoardSide {
+			return nil, &Error{Line: s.line, Column: 1, Reason: "unexpected line after the grid"}
+		}
+
+		row, err := ssRow.read(text, s.line)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rows) == 0 {
+			first = s.line
+		}
+		rows = append(rows, row)
+	}
+
+	if err := s.s.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(rows) < sudoku.BoardSide {
+		return nil, &Error{Line: s.line + 1, Reason: fmt.Sprintf("too few rows, got %d", len(rows))}
+	}
+
+	return newGame(rows, first)
+}
+
+// WriteSS writes the givens of the game in the Simple Sudoku format.
+func WriteSS(w io.Writer, g sudoku.Game) error {
+	rows, err := givens(g)
+	if err != nil {
+		return err
+	}
+
+	sb := strings.Builder{}
+	for r, row := range rows {
+		if r > 0 && r%sudoku.BoardBoxSize == 0 {
+			sb.WriteString("-----------\n")
+		}
+		writeRow(&sb, row, '.', "|")
+	}
+
+	_, err = io.WriteString(w, sb.String())
+	return err
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// isSSSeparator reports whether the line separates boxes or draws the border, such as "-----------" or "*-----*".
+func isSSSeparator(text string) bool {
+	return strings.Trim(text, "-*+| \t") == "" && strings.Contains(text, "-")
+}
@@ -0,0 +1,75 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/lukasaron/sudoku"
+)
+
+const ssFile = `..9|748|...
+7..|...|...
+.2.|1.9|...
+-----------
+..7|...|24.
+.64|.1.|59.
+.98|...|3..
+-----------
+...|8.3|.2.
+...|...|..6
+...|275|9..
+`
+
+func TestReadSS(t *testing.T) {
+	bordered := "*-----------*\n" + strings.Replace(ssFile, "X", ".", -1) + "*-----------*\n"
+	for _, input := range []string{ssFile, bordered, strings.Replace(ssFile, "..6", "XX6", 1)} {
+		g, err := ReadSS(strings.NewReader(input))
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		if g.Compact() != puzzle {
+			t.Errorf("puzzle expected: %s, got: %s", puzzle, g.Compact())
+		}
+	}
+
+	tests := []struct {
+		name   string
+		input  string
+		line   int
+		column int
+	}{
+		{"character", strings.Replace(ssFile, ".64|.1.|59.", ".64|.1.|5y.", 1), 6, 10},
+		{"few rows", strings.Replace(ssFile, "...|275|9..\n", "", 1), 11, 0},
+		{"many rows", ssFile + "...|...|...\n", 12, 1},
+	}
+
+	for _, tc := range tests {
+		_, err := ReadSS(strings.NewReader(tc.input))
+		e, ok := err.(*Error)
+		if !ok {
+			t.Fatalf("%s: format error expected, got: %v", tc.name, err)
+		}
+
+		if e.Line != tc.line || e.Column != tc.column {
+			t.Errorf("%s: line %d and column %d expected, got: %v", tc.name, tc.line, tc.column, e)
+		}
+	}
+}
+
+func TestWriteSS(t *testing.T) {
+	g, err := sudoku.Parse(puzzle)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	buf := bytes.Buffer{}
+	if err = WriteSS(&buf, g); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if buf.String() != ssFile {
+		t.Errorf("file expected:\n%s, got:\n%s", ssFile, buf.String())
+	}
+}
@@ -0,0 +1,274 @@
+package sudoku
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+)
+
+// MinClues is the smallest number of clues of any puzzle with the unique solution.
+const MinClues = 17
+
+// DefaultAttempts is the number of generated puzzles, which are rated before Generate gives up looking for a puzzle
+// in the requested difficulty band.
+const DefaultAttempts = 100
+
+// DefaultMaskAttempts is the number of full grids, which are tried before GenerateFromMask gives up.
+const DefaultMaskAttempts = 10000
+
+// ErrGenerateLimit is returned when no generated puzzle meets the requirements within the attempts or the timeout.
+var ErrGenerateLimit = errors.New("no puzzle meets the requirements within the limits")
+
+// GenerateOptions configures the puzzle generator. The difficulty band is given by the difficulty level, the score
+// range or both of them, where zero values mean no limit.
+type GenerateOptions struct {
+	Rand       *rand.Rand    // source of randomness, nil means the source seeded by the current time
+	Clues      int           // target number of clues, zero means removing clues until the puzzle is minimal
+	Symmetry   Symmetry      // layout of the clues
+	Difficulty Difficulty    // requested difficulty level, Unrated means any level
+	MinScore   float64       // minimal score of the puzzle
+	MaxScore   float64       // maximal score of the puzzle
+	Attempts   int           // number of attempts, zero means the default of the function
+	Timeout    time.Duration // time limit of the generation, zero means no limit
+}
+
+// Generate creates a new puzzle with the unique solution. It fills a random full grid and then removes clues in the
+// random order as long as the solution stays unique. The removal stops at the target number of clues or when no
+// other clue can be removed, hence the puzzle might keep more clues than the target. With the symmetry, the whole
+// orbits of cells are removed at once and the target is never crossed. The same Rand seed produces the same puzzle.
+//
+// When the difficulty band is requested, the puzzles are rated and rejected until one falls into the band.
+// ErrGenerateLimit is returned when the attempts are exhausted or the timeout expires.
+func Generate(opts GenerateOptions) (Game, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	rnd := opts.random()
+	if !opts.banded() {
+		return generate(rnd, opts)
+	}
+
+	return opts.search(DefaultAttempts, func() (*Board, error) {
+		return generate(rnd, opts)
+	})
+}
+
+// GenerateFromMask creates a new puzzle with the unique solution, where the givens sit exactly on the cells of the
+// mask. It searches random full grids until the values on the mask give the unique solution and the puzzle falls
+// into the requested difficulty band. The options Clues and Symmetry are ignored, because the mask gives the layout.
+// Every grid counts as one attempt, where zero means DefaultMaskAttempts, and ErrGenerateLimit is returned when the
+// attempts are exhausted or the timeout expires.
+func GenerateFromMask(mask [BoardSize]bool, opts GenerateOptions) (Game, error) {
+	opts.Clues, opts.Symmetry = 0, NoSymmetry
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	clues := 0
+	for _, m := range mask {
+		if m {
+			clues++
+		}
+	}
+
+	if clues < MinClues {
+		return nil, ErrWrongInput
+	}
+
+	rnd := opts.random()
+	return opts.search(DefaultMaskAttempts, func() (*Board, error) {
+		g := &generator{rnd: rnd}
+		g.fill(0)
+
+		b := NewBoard().(*Board)
+		for idx, m := range mask {
+			if m {
+				b.b[idx] = uint(g.grid[idx])
+			}
+		}
+
+		count, err := b.CountSolutions(2)
+		if err != nil || count != 1 {
+			return nil, err
+		}
+
+		return b, nil
+	})
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+func generate(rnd *rand.Rand, opts GenerateOptions) (*Board, error) {
+	g := &generator{rnd: rnd}
+	g.fill(0)
+
+	b, err := g.grid.board()
+	if err != nil {
+		return nil, err
+	}
+
+	orbits := opts.Symmetry.orbits()
+	rnd.Shuffle(len(orbits), func(i, j int) {
+		orbits[i], orbits[j] = orbits[j], orbits[i]
+	})
+
+	if err := b.removeClues(orbits, opts.Clues); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+func (o GenerateOptions) validate() error {
+	if o.Clues != 0 && (o.Clues < MinClues || o.Clues > BoardSize) {
+		return ErrWrongInput
+	}
+
+	if o.Symmetry < NoSymmetry || o.Symmetry > DihedralSymmetry {
+		return ErrWrongInput
+	}
+
+	if o.Difficulty < Unrated || o.Difficulty > Diabolical || o.MinScore < 0 || o.MaxScore < 0 ||
+		(o.MaxScore > 0 && o.MinScore > o.MaxScore) || o.Attempts < 0 || o.Timeout < 0 {
+		return ErrWrongInput
+	}
+
+	return nil
+}
+
+func (o GenerateOptions) random() *rand.Rand {
+	if o.Rand == nil {
+		return rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return o.Rand
+}
+
+// search calls the candidate until it returns the puzzle in the difficulty band, nil puzzle means the rejected one.
+func (o GenerateOptions) search(attempts int, candidate func() (*Board, error)) (Game, error) {
+	if o.Attempts > 0 {
+		attempts = o.Attempts
+	}
+
+	var deadline time.Time
+	if o.Timeout > 0 {
+		deadline = time.Now().Add(o.Timeout)
+	}
+
+	for i := 0; i < attempts; i++ {
+		if !deadline.IsZero() && time.Now().After(deadline) {
+			break
+		}
+
+		b, err := candidate()
+		if err != nil {
+			return nil, err
+		}
+
+		if b == nil {
+			continue
+		}
+
+		if !o.banded() {
+			return b, nil
+		}
+
+		r, err := Rate(b)
+		if err != nil {
+			return nil, err
+		}
+
+		if o.accepts(r) {
+			return b, nil
+		}
+	}
+
+	return nil, ErrGenerateLimit
+}
+
+func (o GenerateOptions) banded() bool {
+	return o.Difficulty != Unrated || o.MinScore > 0 || o.MaxScore > 0
+}
+
+func (o GenerateOptions) accepts(r Rating) bool {
+	if o.Difficulty != Unrated && r.Difficulty != o.Difficulty {
+		return false
+	}
+
+	if o.MinScore > 0 && r.Score < o.MinScore {
+		return false
+	}
+
+	return o.MaxScore == 0 || r.Score <= o.MaxScore
+}
+
+// generator fills the grid by the backtracking with the random order of digits.
+type generator struct {
+	rnd                  *rand.Rand
+	grid                 Grid
+	rows, columns, boxes [BoardSide]uint16
+}
+
+func (g *generator) fill(idx int) bool {
+	if idx == BoardSize {
+		return true
+	}
+
+	r, c, x := rowOf[idx], columnOf[idx], boxOf[idx]
+	used := g.rows[r] | g.columns[c] | g.boxes[x]
+	for _, d := range g.rnd.Perm(MaxValue) {
+		bit := digitBit(d + 1)
+		if used&bit != 0 {
+			continue
+		}
+
+		g.grid[idx] = d + 1
+		g.rows[r], g.columns[c], g.boxes[x] = g.rows[r]|bit, g.columns[c]|bit, g.boxes[x]|bit
+		if g.fill(idx + 1) {
+			return true
+		}
+		g.rows[r], g.columns[c], g.boxes[x] = g.rows[r]&^bit, g.columns[c]&^bit, g.boxes[x]&^bit
+	}
+
+	g.grid[idx] = 0
+	return false
+}
+
+// removeClues empties the orbits of cells in the given order, when the solution stays unique and the board keeps at
+// least the target number of clues. Removing a clue never reduces the number of solutions, so one pass leaves the
+// minimal puzzle.
+func (b *Board) removeClues(orbits [][]int, target int) error {
+	clues := 0
+	for _, v := range b.b {
+		if v > 0 {
+			clues++
+		}
+	}
+
+	for _, orbit := range orbits {
+		if clues-len(orbit) < target {
+			continue
+		}
+
+		values := make([]uint, len(orbit))
+		for i, idx := range orbit {
+			values[i], b.b[idx] = b.b[idx], 0
+		}
+
+		count, err := b.CountSolutions(2)
+		if err != nil {
+			return err
+		}
+
+		if count != 1 {
+			for i, idx := range orbit {
+				b.b[idx] = values[i]
+			}
+			continue
+		}
+		clues -= len(orbit)
+	}
+
+	return nil
+}
@@ -0,0 +1,220 @@
+package sudoku
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGenerate(t *testing.T) {
+	g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(1))})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !g.HasUniqueSolution() {
+		t.Errorf("unique solution expected, got:\n%s", g)
+	}
+
+	// the puzzle is minimal, none of the clues can be removed
+	for r := 0; r < BoardSide; r++ {
+		for c := 0; c < BoardSide; c++ {
+			if g.IsEmpty(r, c) {
+				continue
+			}
+
+			v := g.Value(r, c)
+			if g.SetValue(r, c, 0).HasUniqueSolution() {
+				t.Errorf("clue (%d, %d) is redundant in:\n%s", r, c, g)
+			}
+			g.SetValue(r, c, v)
+		}
+	}
+
+	same, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(1))})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(g.Board(), same.Board()) {
+		t.Errorf("the same seed should generate the same puzzle, got:\n%s\nand:\n%s", g, same)
+	}
+}
+
+func TestGenerate_Clues(t *testing.T) {
+	g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(2)), Clues: 40})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if clues := countClues(g); clues != 40 {
+		t.Errorf("40 clues expected, got: %d", clues)
+	}
+
+	if !g.HasUniqueSolution() {
+		t.Errorf("unique solution expected, got:\n%s", g)
+	}
+
+	g, err = Generate(GenerateOptions{Rand: rand.New(rand.NewSource(2)), Clues: BoardSize})
+	if err != nil || countClues(g) != BoardSize || !g.IsValid() {
+		t.Errorf("full valid grid expected, got: %v\n%s", err, g)
+	}
+
+	for _, clues := range []int{-1, MinClues - 1, BoardSize + 1} {
+		if _, err = Generate(GenerateOptions{Clues: clues}); err != ErrWrongInput {
+			t.Errorf("wrong input error expected for %d clues, got: %v", clues, err)
+		}
+	}
+}
+
+func TestGenerate_Difficulty(t *testing.T) {
+	for d := Easy; d <= Diabolical; d++ {
+		g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(3)), Difficulty: d})
+		if err != nil {
+			t.Fatalf("%v: error not expected, got: %v", d, err)
+		}
+
+		if r, err := Rate(g); err != nil || r.Difficulty != d {
+			t.Errorf("%v puzzle expected, got: %v, %v", d, r.Difficulty, err)
+		}
+	}
+
+	g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(3)), MinScore: 3.0, MaxScore: 4.5})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if r, err := Rate(g); err != nil || r.Score < 3.0 || r.Score > 4.5 {
+		t.Errorf("score between 3.0 and 4.5 expected, got: %.1f, %v", r.Score, err)
+	}
+
+	// the easy puzzle cannot have the score of the expert one
+	_, err = Generate(GenerateOptions{Difficulty: Easy, MinScore: 4.0, Attempts: 5})
+	if err != ErrGenerateLimit {
+		t.Errorf("generate limit error expected, got: %v", err)
+	}
+
+	// the band can be met, only the deadline stops the search
+	_, err = Generate(GenerateOptions{Rand: rand.New(rand.NewSource(3)), Difficulty: Easy, Attempts: 1000000,
+		Timeout: time.Nanosecond})
+	if err != ErrGenerateLimit {
+		t.Errorf("generate limit error expected, got: %v", err)
+	}
+
+	wrong := []GenerateOptions{
+		{Difficulty: Diabolical + 1},
+		{MinScore: -1},
+		{MinScore: 4.5, MaxScore: 3.0},
+		{Attempts: -1},
+		{Timeout: -time.Second},
+	}
+
+	for _, opts := range wrong {
+		if _, err = Generate(opts); err != ErrWrongInput {
+			t.Errorf("wrong input error expected for %+v, got: %v", opts, err)
+		}
+	}
+}
+
+func TestGenerate_Symmetry(t *testing.T) {
+	for s := NoSymmetry; s <= DihedralSymmetry; s++ {
+		g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(4)), Symmetry: s})
+		if err != nil {
+			t.Fatalf("%v: error not expected, got: %v", s, err)
+		}
+
+		if !g.HasUniqueSolution() {
+			t.Errorf("%v: unique solution expected, got:\n%s", s, g)
+		}
+
+		for _, orbit := range s.orbits() {
+			empty := g.IsEmpty(rowOf[orbit[0]], columnOf[orbit[0]])
+			for _, idx := range orbit {
+				if g.IsEmpty(rowOf[idx], columnOf[idx]) != empty {
+					t.Errorf("%v: symmetric clues expected in %v, got:\n%s", s, cellsOf(orbit), g)
+					break
+				}
+			}
+		}
+	}
+
+	g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(4)), Symmetry: DihedralSymmetry, Clues: 40})
+	if err != nil || countClues(g) < 40 {
+		t.Errorf("at least 40 clues expected, got: %v\n%s", err, g)
+	}
+
+	if _, err = Generate(GenerateOptions{Symmetry: DihedralSymmetry + 1}); err != ErrWrongInput {
+		t.Errorf("wrong input error expected, got: %v", err)
+	}
+}
+
+func TestGenerateFromMask(t *testing.T) {
+	layout, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(5)), Symmetry: RotationalSymmetry, Clues: 30})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	var mask [BoardSize]bool
+	for idx := range mask {
+		mask[idx] = !layout.IsEmpty(rowOf[idx], columnOf[idx])
+	}
+
+	g, err := GenerateFromMask(mask, GenerateOptions{Rand: rand.New(rand.NewSource(6))})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !g.HasUniqueSolution() {
+		t.Errorf("unique solution expected, got:\n%s", g)
+	}
+
+	for idx, m := range mask {
+		if g.IsEmpty(rowOf[idx], columnOf[idx]) == m {
+			t.Fatalf("givens on the mask expected, got:\n%s", g)
+		}
+	}
+
+	// two full rows cannot give the unique solution
+	var rows [BoardSize]bool
+	for idx := 0; idx < 2*BoardSide; idx++ {
+		rows[idx] = true
+	}
+
+	if _, err = GenerateFromMask(rows, GenerateOptions{Attempts: 10}); err != ErrGenerateLimit {
+		t.Errorf("generate limit error expected, got: %v", err)
+	}
+
+	rows[0], rows[1] = false, false
+	if _, err = GenerateFromMask(rows, GenerateOptions{}); err != ErrWrongInput {
+		t.Errorf("wrong input error expected, got: %v", err)
+	}
+}
+
+func TestSymmetry_String(t *testing.T) {
+	if RotationalSymmetry.String() != "Rotational" || Symmetry(-1).String() != "Unknown Symmetry" {
+		t.Errorf("names of symmetries expected, got: %s, %s", RotationalSymmetry, Symmetry(-1))
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		if _, err := Generate(GenerateOptions{Rand: rnd}); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func countClues(g Game) int {
+	clues := 0
+	for _, row := range g.Board() {
+		for _, v := range row {
+			if v > 0 {
+				clues++
+			}
+		}
+	}
+
+	return clues
+}
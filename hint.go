@@ -0,0 +1,162 @@
+package sudoku
+
+// Hint is the next logical deduction on the board, which doesn't reveal anything that cannot be deduced yet.
+type Hint struct {
+	Technique    Technique   // technique of the deduction
+	Cell         Cell        // cell where the value can be placed
+	Value        int         // value to place, zero when the hint only eliminates candidates
+	Eliminations []Candidate // candidates that can be removed
+	Reasons      []Cell      // cells that make the deduction possible and should be highlighted
+	Step         Step        // the complete deduction
+}
+
+// NextHint method returns the simplest deduction available on the board. The pencil marks of cells restrict their
+// candidates, so the eliminations of the hint applied by ApplyHint lead to the next deduction. ErrNoLogicalStep is
+// returned when none of the techniques can make any progress and ErrNoSolution when the values or the pencil marks
+// contradict each other.
+// When there is a state error this method returns empty hint and the state error.
+func (b *Board) NextHint() (Hint, error) {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return Hint{}, b.e
+	}
+
+	if !b.IsValid() {
+		return Hint{}, ErrWrongInput
+	}
+
+	l := b.logic()
+	if l.broken() {
+		return Hint{}, ErrNoSolution
+	}
+
+	s, ok := l.next()
+	if !ok {
+		return Hint{}, ErrNoLogicalStep
+	}
+
+	h := Hint{
+		Technique:    s.Technique,
+		Eliminations: s.Eliminations,
+		Reasons:      l.reasons(s),
+		Step:         s,
+	}
+
+	if len(s.Placements) > 0 {
+		h.Cell = s.Placements[0].Cell
+		h.Value = s.Placements[0].Value
+	}
+
+	return h, nil
+}
+
+// ApplyHint method applies the hint to the board: the value is placed as the player entry, the eliminations are
+// removed from the pencil marks. The cell without any pencil marks gets its logical candidates first, so the next
+// hint continues from the eliminations.
+// When there is a state error this method does nothing.
+func (b *Board) ApplyHint(h Hint) Game {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return b
+	}
+
+	if h.Value > 0 {
+		return b.SetEntry(h.Cell.Row, h.Cell.Column, h.Value)
+	}
+
+	l := b.logic()
+	for _, e := range h.Eliminations {
+		idx, err := b.index(e.Row, e.Column)
+		if err != nil {
+			b.e = err
+			return b
+		}
+
+		if b.b[idx] > 0 {
+			continue
+		}
+
+		if b.c[idx] == 0 {
+			b.c[idx] = l.candidates[idx]
+		}
+		b.c[idx] &^= digitBit(e.Value)
+	}
+
+	return b
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// logic returns the logical solver of the board, where the pencil marks restrict the candidates of cells.
+func (b *Board) logic() *logic {
+	l := newLogic(b.grid())
+	for idx, marks := range b.c {
+		if marks != 0 && b.b[idx] == 0 {
+			l.candidates[idx] &= marks
+		}
+	}
+
+	return l
+}
+
+// reasons returns the cells explaining the step. Singles are explained by the placed values, which rule out the
+// other options, or by the cells whose pencil marks rule them out. Any other technique by the cells of its pattern.
+func (l *logic) reasons(s Step) []Cell {
+	switch s.Technique {
+	case HiddenSingle:
+		p := s.Placements[0]
+		target := p.Row*BoardSide + p.Column
+		u := unitOf(s.Houses[0])
+
+		var reasons []int
+		for _, idx := range unitCells[u] {
+			if idx == target || l.values[idx] > 0 {
+				continue
+			}
+
+			// the first placed value, which rules the digit out of the cell, otherwise its own pencil marks do
+			ruled := false
+			for _, peer := range peersOf[idx] {
+				if l.values[peer] == p.Value {
+					if !contains(reasons, peer) {
+						reasons = append(reasons, peer)
+					}
+					ruled = true
+					break
+				}
+			}
+
+			if !ruled {
+				reasons = append(reasons, idx)
+			}
+		}
+
+		return cellsOf(reasons)
+	case NakedSingle:
+		p := s.Placements[0]
+		target := p.Row*BoardSide + p.Column
+
+		var reasons []int
+		var seen uint16
+		for _, peer := range peersOf[target] {
+			v := l.values[peer]
+			if v > 0 && seen&digitBit(v) == 0 {
+				seen |= digitBit(v)
+				reasons = append(reasons, peer)
+			}
+		}
+
+		// the other digits are ruled out by the pencil marks of the cell
+		if seen|digitBit(p.Value) != allDigits {
+			reasons = append(reasons, target)
+		}
+
+		return cellsOf(reasons)
+	default:
+		return s.Cells
+	}
+}
+
+func unitOf(h House) int {
+	return int(h.Kind)*BoardSide + h.Index
+}
@@ -0,0 +1,202 @@
+package sudoku
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestBoard_NextHint(t *testing.T) {
+	g := singlesGame()
+	board := g.Board()
+	solution := singlesGame()
+	if err := solution.Solve(); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	h, err := g.NextHint()
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if h.Technique != HiddenSingle || h.Value == 0 {
+		t.Errorf("hidden single placement expected, got: %+v", h)
+	}
+
+	if solution.Value(h.Cell.Row, h.Cell.Column) != h.Value {
+		t.Errorf("hint should place the solution value, got: %+v", h)
+	}
+
+	if len(h.Reasons) == 0 {
+		t.Error("reasons of the hint expected")
+	}
+
+	for _, r := range h.Reasons {
+		if g.Value(r.Row, r.Column) != h.Value {
+			t.Errorf("reason cells of the hidden single should hold the value %d, got: %+v", h.Value, r)
+		}
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("hint shouldn't change the board")
+	}
+
+	// following the hints solves the puzzle
+	for i := 0; i < BoardSize && g.(*Board).emptyValueIndex() >= 0; i++ {
+		h, err = g.NextHint()
+		if err != nil || h.Value == 0 {
+			t.Fatalf("placement expected, got: %+v, %v", h, err)
+		}
+		g.SetValue(h.Cell.Row, h.Cell.Column, h.Value)
+	}
+
+	if !reflect.DeepEqual(g.Board(), solution.Board()) {
+		t.Errorf("sudoku solved expected:\n%s, got:\n%s", solution, g)
+	}
+
+	if _, err = g.NextHint(); err != ErrNoLogicalStep {
+		t.Errorf("no logical step error expected for the solved board, got: %v", err)
+	}
+}
+
+func TestBoard_NextHintNakedSingle(t *testing.T) {
+	// the cell [8, 8] sees all digits but 9, there is nothing else to deduce
+	g := NewBoard().
+		SetRow(8, []int{1, 2, 3, 4, 5, 0, 0, 0, 0}).
+		SetColumn(8, []int{6, 7, 8, 0, 0, 0, 0, 0, 0})
+
+	h, err := g.NextHint()
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if h.Technique != NakedSingle || h.Cell != (Cell{Row: 8, Column: 8}) || h.Value != 9 {
+		t.Errorf("naked single 9 in the cell [8, 8] expected, got: %+v", h)
+	}
+
+	if len(h.Reasons) != 8 {
+		t.Errorf("8 reason cells expected, got: %v", h.Reasons)
+	}
+}
+
+func TestBoard_NextHintErrors(t *testing.T) {
+	if _, err := guessingGame().NextHint(); err != nil {
+		t.Errorf("error not expected, the AI Escargot starts with a hidden single, got: %v", err)
+	}
+
+	if _, err := noSolutionGame().NextHint(); err != ErrNoSolution {
+		t.Errorf("no solution error expected, got: %v", err)
+	}
+
+	if _, err := NewBoard().SetValue(-1, 0, 1).NextHint(); err == nil {
+		t.Error("state error expected")
+	}
+}
+
+func TestBoard_NextHintCandidates(t *testing.T) {
+	generated, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(0))})
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	for _, g := range []Game{easyGame(), generated} {
+		solution := NewBoard().SetBoard(g.Board())
+		if err = solution.Solve(); err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		eliminations := 0
+
+		// the eliminations applied to pencil marks lead to the next deductions, until the puzzle is solved
+		for i := 0; i < 10*BoardSize && g.(*Board).emptyValueIndex() >= 0; i++ {
+			h, err := g.NextHint()
+			if err != nil {
+				t.Fatalf("error not expected, got: %v", err)
+			}
+
+			if h.Value > 0 {
+				checkReasons(t, g, h)
+			} else {
+				eliminations++
+			}
+			g.ApplyHint(h)
+		}
+
+		if eliminations == 0 {
+			t.Error("hints with eliminations expected")
+		}
+
+		if !reflect.DeepEqual(g.Board(), solution.Board()) {
+			t.Errorf("sudoku solved expected:\n%s, got:\n%s", solution, g)
+		}
+	}
+
+	// wrong pencil marks contradict the values
+	g := easyGame().SetCandidates(0, 0, []int{1})
+	if _, err := g.NextHint(); err != ErrNoSolution {
+		t.Errorf("no solution error expected, got: %v", err)
+	}
+}
+
+func TestBoard_ApplyHint(t *testing.T) {
+	// the value is placed as the player entry
+	g := easyGame()
+	h, err := g.NextHint()
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	g.ApplyHint(h)
+	if g.Value(h.Cell.Row, h.Cell.Column) != h.Value || g.IsGiven(h.Cell.Row, h.Cell.Column) {
+		t.Errorf("entry %d at %v expected, got: %d", h.Value, h.Cell, g.Value(h.Cell.Row, h.Cell.Column))
+	}
+
+	// the eliminations are removed from the logical candidates and the existing pencil marks
+	g = easyGame().SetCandidates(0, 1, []int{1, 3})
+	g.ApplyHint(Hint{Eliminations: []Candidate{{Cell{0, 0}, 1}, {Cell{0, 1}, 1}}})
+	want := digitsOf(newLogic(easyGame().(*Board).grid()).candidates[0] &^ digitBit(1))
+	if c := g.Candidates(0, 0); !reflect.DeepEqual(c, want) {
+		t.Errorf("candidates %v expected, got: %v", want, c)
+	}
+
+	if c := g.Candidates(0, 1); !reflect.DeepEqual(c, []int{3}) {
+		t.Errorf("candidates %v expected, got: %v", []int{3}, c)
+	}
+
+	g.ApplyHint(Hint{Eliminations: []Candidate{{Cell{9, 0}, 1}}})
+	if g.Error() != errOutOfBoardIndex {
+		t.Errorf("out of board index error expected, got: %v", g.Error())
+	}
+}
+
+// checkReasons verifies that the reasons of the single rule out every other option: the digit from other cells of
+// the house for the hidden single and other digits from the cell for the naked one.
+func checkReasons(t *testing.T, g Game, h Hint) {
+	t.Helper()
+
+	target := h.Cell.Row*BoardSide + h.Cell.Column
+	explains := func(idx, digit int) bool {
+		for _, r := range h.Reasons {
+			reason := r.Row*BoardSide + r.Column
+			if reason == idx || (g.Value(r.Row, r.Column) == digit && sees(idx, reason)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch h.Technique {
+	case HiddenSingle:
+		for _, idx := range unitCells[unitOf(h.Step.Houses[0])] {
+			if idx != target && g.IsEmpty(rowOf[idx], columnOf[idx]) && !explains(idx, h.Value) {
+				t.Errorf("hint %v: cell %v is not explained by reasons %v", h.Step, cellOf(idx), h.Reasons)
+			}
+		}
+	case NakedSingle:
+		for d := 1; d <= MaxValue; d++ {
+			if d != h.Value && !explains(target, d) {
+				t.Errorf("hint %v: digit %d is not explained by reasons %v", h.Step, d, h.Reasons)
+			}
+		}
+	}
+}
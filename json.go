@@ -0,0 +1,121 @@
+package sudoku
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// StandardVariant is the variant of the classic 9x9 Sudoku, the only one supported by the Board.
+const StandardVariant = "standard"
+
+// ErrUnsupportedVariant is returned when the JSON describes the variant other than the StandardVariant.
+var ErrUnsupportedVariant = errors.New("unsupported variant")
+
+// boardJSON is the JSON schema of the Board:
+//
+//	{
+//	  "variant":    "standard",          // variant of the puzzle, empty means standard
+//	  "givens":     "..9748...7....",    // 81 characters of the puzzle values, '.' is the cell without any
+//	  "entries":    "1.............",    // 81 characters of the player entries, '.' is the cell without any
+//	  "candidates": [[], [2, 5], ...]    // optional, 81 lists of pencil marks ordered row by row
+//	}
+//
+// Both givens and entries are read by Parse, so any of its blank characters and decoration can be used.
+type boardJSON struct {
+	Variant    string  `json:"variant"`
+	Givens     string  `json:"givens"`
+	Entries    string  `json:"entries"`
+	Candidates [][]int `json:"candidates,omitempty"`
+}
+
+// MarshalJSON method implements the json.Marshaler interface. The board is written with its givens, player entries
+// and pencil marks. When there is a state error this method returns nil and the state error.
+func (b Board) MarshalJSON() ([]byte, error) {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return nil, b.e
+	}
+
+	givens, entries := NewBoard().(*Board), NewBoard().(*Board)
+	for idx, v := range b.b {
+		if b.p[idx] {
+			entries.b[idx] = v
+		} else {
+			givens.b[idx] = v
+		}
+	}
+
+	j := boardJSON{
+		Variant: StandardVariant,
+		Givens:  givens.Compact(),
+		Entries: entries.Compact(),
+	}
+
+	for idx, marks := range b.c {
+		if marks == 0 {
+			continue
+		}
+
+		if j.Candidates == nil {
+			j.Candidates = make([][]int, BoardSize)
+			for i := range j.Candidates {
+				j.Candidates[i] = []int{}
+			}
+		}
+		j.Candidates[idx] = digitsOf(marks)
+	}
+
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON method implements the json.Unmarshaler interface. The board is replaced including its state error,
+// however it stays untouched when the JSON is not valid. The givens cannot collide in any row, column or box and the
+// cell cannot have both the given and the entry, while the player entries can be wrong. Only the empty cells can have
+// pencil marks.
+func (b *Board) UnmarshalJSON(data []byte) error {
+	var j boardJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+
+	if j.Variant != "" && j.Variant != StandardVariant {
+		return ErrUnsupportedVariant
+	}
+
+	givens, err := Parse(j.Givens)
+	if err != nil {
+		return err
+	}
+
+	entries, err := parse(j.Entries, false)
+	if err != nil {
+		return err
+	}
+
+	if j.Candidates != nil && len(j.Candidates) != BoardSize {
+		return ErrWrongInput
+	}
+
+	board := givens.(*Board)
+	for idx, v := range entries.b {
+		if v == 0 {
+			continue
+		}
+
+		if board.b[idx] > 0 {
+			return ErrWrongInput
+		}
+		board.b[idx], board.p[idx] = v, true
+	}
+
+	for idx, marks := range j.Candidates {
+		board.SetCandidates(rowOf[idx], columnOf[idx], marks)
+	}
+
+	if board.e != nil {
+		return board.e
+	}
+
+	*b = *board
+	return nil
+}
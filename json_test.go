@@ -0,0 +1,110 @@
+package sudoku
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBoard_MarshalJSON(t *testing.T) {
+	g := easyGame().SetEntry(0, 0, 9).SetCandidates(0, 1, []int{2, 5}).(*Board)
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	var j map[string]interface{}
+	if err = json.Unmarshal(data, &j); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	entries := "9" + strings.Repeat(".", BoardSize-1)
+	if j["variant"] != StandardVariant || j["givens"] != easyGame().Compact() || j["entries"] != entries {
+		t.Errorf("variant, givens and entries expected, got: %s", data)
+	}
+
+	decoded := NewBoard().(*Board)
+	if err = json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, g) {
+		t.Errorf("the same board expected after round trip of %s", data)
+	}
+
+	if data, err = json.Marshal(easyGame()); err != nil || strings.Contains(string(data), "candidates") {
+		t.Errorf("board without candidates expected, got: %s, %v", data, err)
+	}
+
+	// the wrong player entries are the game state as well
+	wrong := easyGame().SetEntry(0, 0, 1).SetEntry(1, 0, 1).(*Board)
+	if data, err = json.Marshal(wrong); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	decoded = NewBoard().(*Board)
+	if err = json.Unmarshal(data, decoded); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(decoded, wrong) {
+		t.Errorf("the same board with conflicting entries expected after round trip of %s", data)
+	}
+
+	if _, err = json.Marshal(NewBoard().SetValue(BoardSide, 0, 1)); err == nil {
+		t.Error("state error expected")
+	}
+}
+
+func TestBoard_UnmarshalJSON(t *testing.T) {
+	empty := strings.Repeat(".", BoardSize)
+	given := "1" + empty[1:]
+	candidates := make([]string, BoardSize)
+	for i := range candidates {
+		candidates[i] = "[]"
+	}
+
+	candidates[1] = "[2, 3]"
+	valid := `{"givens": "` + given + `", "entries": "` + empty + `", "candidates": [` + strings.Join(candidates, ",") + `]}`
+
+	b := NewBoard().(*Board)
+	if err := json.Unmarshal([]byte(valid), b); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if !b.IsGiven(0, 0) || !reflect.DeepEqual(b.Candidates(0, 1), []int{2, 3}) {
+		t.Errorf("given and candidates expected, got:\n%s", b)
+	}
+
+	candidates[0] = "[4]"
+	tests := []struct {
+		name string
+		data string
+		err  error
+	}{
+		{"variant", `{"variant": "killer", "givens": "` + empty + `", "entries": "` + empty + `"}`, ErrUnsupportedVariant},
+		{"conflict", `{"givens": "` + given + `", "entries": "` + given + `"}`, ErrWrongInput},
+		{"short candidates", `{"givens": "` + empty + `", "entries": "` + empty + `", "candidates": [[1]]}`, ErrWrongInput},
+		{"filled candidates", `{"givens": "` + given + `", "entries": "` + empty + `", "candidates": [` + strings.Join(candidates, ",") + `]}`, ErrWrongInput},
+	}
+
+	for _, tc := range tests {
+		if err := json.Unmarshal([]byte(tc.data), b); err != tc.err {
+			t.Errorf("%s: error %v expected, got: %v", tc.name, tc.err, err)
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"givens": "123", "entries": ""}`), b); err == nil {
+		t.Error("parse error expected")
+	}
+
+	conflicting := `{"givens": "11` + empty[2:] + `", "entries": "` + empty + `"}`
+	if _, ok := json.Unmarshal([]byte(conflicting), b).(*ParseError); !ok {
+		t.Error("parse error expected for conflicting givens")
+	}
+
+	if !b.IsGiven(0, 0) || !reflect.DeepEqual(b.Candidates(0, 1), []int{2, 3}) {
+		t.Error("board should stay untouched on errors")
+	}
+}
@@ -0,0 +1,953 @@
+package sudoku
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// ErrNoLogicalStep is returned when none of the human techniques can make any progress on the board.
+var ErrNoLogicalStep = errors.New("no logical step available")
+
+// Technique is the named human solving technique.
+type Technique int
+
+// Techniques ordered from the simplest one, the logical solver always uses the simplest technique available.
+const (
+	HiddenSingle Technique = iota
+	NakedSingle
+	LockedCandidatesPointing
+	LockedCandidatesClaiming
+	NakedPair
+	XWing
+	HiddenPair
+	NakedTriple
+	Swordfish
+	HiddenTriple
+	XYWing
+	XYZWing
+	SimpleColouring
+	NakedQuad
+	Jellyfish
+	HiddenQuad
+	techniqueCount // number of techniques, not a technique itself
+)
+
+var techniqueNames = [techniqueCount]string{
+	"Hidden Single",
+	"Naked Single",
+	"Locked Candidates (Pointing)",
+	"Locked Candidates (Claiming)",
+	"Naked Pair",
+	"X-Wing",
+	"Hidden Pair",
+	"Naked Triple",
+	"Swordfish",
+	"Hidden Triple",
+	"XY-Wing",
+	"XYZ-Wing",
+	"Simple Colouring",
+	"Naked Quad",
+	"Jellyfish",
+	"Hidden Quad",
+}
+
+// String method returns the name of the technique.
+func (t Technique) String() string {
+	if t < 0 || t >= techniqueCount {
+		return "Unknown Technique"
+	}
+
+	return techniqueNames[t]
+}
+
+// HouseKind is the kind of the house - row, column or box.
+type HouseKind int
+
+// Kinds of houses.
+const (
+	RowHouse HouseKind = iota
+	ColumnHouse
+	BoxHouse
+)
+
+// String method returns the name of the house kind.
+func (k HouseKind) String() string {
+	switch k {
+	case RowHouse:
+		return "row"
+	case ColumnHouse:
+		return "column"
+	case BoxHouse:
+		return "box"
+	default:
+		return "unknown"
+	}
+}
+
+// House is a row, column or box of the board, where the index starts at 0 and the maximal value is 8.
+type House struct {
+	Kind  HouseKind
+	Index int
+}
+
+// Cell is the position on the board, both row and column start at 0 and the maximal value is 8.
+type Cell struct {
+	Row    int
+	Column int
+}
+
+// Candidate is the value in the specific cell, it describes both placed values and eliminated candidates.
+type Candidate struct {
+	Cell
+	Value int
+}
+
+// Step is one deduction made by the logical solver.
+type Step struct {
+	Technique    Technique   // technique used
+	Houses       []House     // houses where the pattern has been found, the singles add the ones ruling out the rest
+	Digits       []int       // digits forming the pattern
+	Cells        []Cell      // cells forming the pattern
+	Placements   []Candidate // values placed into the cells
+	Eliminations []Candidate // candidates removed from the cells
+}
+
+// SolveLogically method solves the board by human techniques only and returns the steps in the order they have been
+// made. When the techniques are not enough the steps made so far are returned together with ErrNoLogicalStep and the
+// values stay untouched. When there is a state error this method returns nil and the state error.
+func (b *Board) SolveLogically() ([]Step, error) {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return nil, b.e
+	}
+
+	if !b.IsValid() {
+		return nil, ErrWrongInput
+	}
+
+	l := newLogic(b.grid())
+	steps, err := l.solve()
+	if err != nil {
+		return steps, err
+	}
+
+	b.setGrid(l.values)
+	b.s = true
+	return steps, nil
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// houseOrder is the order of units the techniques look into, boxes are the easiest to scan.
+var houseOrder = unitOrder()
+
+// peersOf keeps the indexes of all cells that share a unit with the cell.
+var peersOf = peerIndexes()
+
+func unitOrder() []int {
+	order := make([]int, 0, 3*BoardSide)
+	for u := 2 * BoardSide; u < 3*BoardSide; u++ {
+		order = append(order, u)
+	}
+	for u := 0; u < 2*BoardSide; u++ {
+		order = append(order, u)
+	}
+
+	return order
+}
+
+func peerIndexes() (peers [BoardSize][]int) {
+	for idx := 0; idx < BoardSize; idx++ {
+		for p := 0; p < BoardSize; p++ {
+			if sees(idx, p) {
+				peers[idx] = append(peers[idx], p)
+			}
+		}
+	}
+
+	return peers
+}
+
+// sees reports whether two different cells share a unit.
+func sees(a, b int) bool {
+	return a != b && (rowOf[a] == rowOf[b] || columnOf[a] == columnOf[b] || boxOf[a] == boxOf[b])
+}
+
+func houseOf(u int) House {
+	return House{Kind: HouseKind(u / BoardSide), Index: u % BoardSide}
+}
+
+func cellOf(idx int) Cell {
+	return Cell{Row: rowOf[idx], Column: columnOf[idx]}
+}
+
+func digitBit(d int) uint16 {
+	return uint16(1) << uint(d)
+}
+
+// digitsOf returns the digits of the bitmask in the ascending order.
+func digitsOf(mask uint16) []int {
+	digits := make([]int, 0, bits.OnesCount16(mask))
+	for d := 1; d <= MaxValue; d++ {
+		if mask&digitBit(d) != 0 {
+			digits = append(digits, d)
+		}
+	}
+
+	return digits
+}
+
+// combinations calls visit for every k-element combination of indexes 0 to n-1 until visit returns true.
+func combinations(n, k int, visit func([]int) bool) bool {
+	c := make([]int, k)
+	var next func(start, depth int) bool
+	next = func(start, depth int) bool {
+		if depth == k {
+			return visit(c)
+		}
+
+		for i := start; i <= n-k+depth; i++ {
+			c[depth] = i
+			if next(i+1, depth+1) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	return next(0, 0)
+}
+
+// logic keeps the values together with candidates of the empty cells, which are used by human techniques.
+type logic struct {
+	values     Grid
+	candidates [BoardSize]uint16
+}
+
+func newLogic(g Grid) *logic {
+	l := &logic{}
+	for idx, v := range g {
+		if v == 0 {
+			l.candidates[idx] = allDigits
+		}
+	}
+
+	for idx, v := range g {
+		if v > 0 {
+			l.place(idx, v)
+		}
+	}
+
+	return l
+}
+
+func (l *logic) solve() ([]Step, error) {
+	var steps []Step
+	for !l.solved() {
+		if l.broken() {
+			return steps, ErrNoSolution
+		}
+
+		s, ok := l.next()
+		if !ok {
+			return steps, ErrNoLogicalStep
+		}
+
+		l.apply(s)
+		steps = append(steps, s)
+	}
+
+	return steps, nil
+}
+
+// next finds the step made by the simplest technique available.
+func (l *logic) next() (Step, bool) {
+	for t := Technique(0); t < techniqueCount; t++ {
+		if s, ok := l.find(t); ok {
+			return s, true
+		}
+	}
+
+	return Step{}, false
+}
+
+func (l *logic) find(t Technique) (Step, bool) {
+	switch t {
+	case HiddenSingle:
+		return l.hiddenSingle()
+	case NakedSingle:
+		return l.nakedSingle()
+	case LockedCandidatesPointing:
+		return l.pointing()
+	case LockedCandidatesClaiming:
+		return l.claiming()
+	case NakedPair:
+		return l.nakedSubset(NakedPair, 2)
+	case NakedTriple:
+		return l.nakedSubset(NakedTriple, 3)
+	case NakedQuad:
+		return l.nakedSubset(NakedQuad, 4)
+	case HiddenPair:
+		return l.hiddenSubset(HiddenPair, 2)
+	case HiddenTriple:
+		return l.hiddenSubset(HiddenTriple, 3)
+	case HiddenQuad:
+		return l.hiddenSubset(HiddenQuad, 4)
+	case XWing:
+		return l.fish(XWing, 2)
+	case Swordfish:
+		return l.fish(Swordfish, 3)
+	case Jellyfish:
+		return l.fish(Jellyfish, 4)
+	case XYWing:
+		return l.xyWing()
+	case XYZWing:
+		return l.xyzWing()
+	case SimpleColouring:
+		return l.simpleColouring()
+	default:
+		return Step{}, false
+	}
+}
+
+func (l *logic) apply(s Step) {
+	for _, p := range s.Placements {
+		l.place(p.Row*BoardSide+p.Column, p.Value)
+	}
+
+	for _, e := range s.Eliminations {
+		l.candidates[e.Row*BoardSide+e.Column] &^= digitBit(e.Value)
+	}
+}
+
+// place puts the value into the cell and removes it from candidates of all peers.
+func (l *logic) place(idx, v int) {
+	l.values[idx] = v
+	l.candidates[idx] = 0
+	for _, p := range peersOf[idx] {
+		l.candidates[p] &^= digitBit(v)
+	}
+}
+
+func (l *logic) solved() bool {
+	for _, v := range l.values {
+		if v == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// broken reports whether there is an empty cell without candidates or a missing digit without any cell in a unit.
+func (l *logic) broken() bool {
+	for idx, v := range l.values {
+		if v == 0 && l.candidates[idx] == 0 {
+			return true
+		}
+	}
+
+	for u := range unitCells {
+		var seen uint16
+		for _, idx := range unitCells[u] {
+			seen |= l.candidates[idx] | digitBit(l.values[idx])
+		}
+
+		if seen&allDigits != allDigits {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cellsWith returns the cells of the unit that have the digit as a candidate.
+func (l *logic) cellsWith(u, d int) []int {
+	var cells []int
+	for _, idx := range unitCells[u] {
+		if l.candidates[idx]&digitBit(d) != 0 {
+			cells = append(cells, idx)
+		}
+	}
+
+	return cells
+}
+
+// eliminate returns the eliminations of the digit from the cells, which are not excluded and have the candidate.
+func (l *logic) eliminate(cells []int, d int, excluded func(int) bool) []Candidate {
+	var eliminations []Candidate
+	for _, idx := range cells {
+		if l.candidates[idx]&digitBit(d) != 0 && !excluded(idx) {
+			eliminations = append(eliminations, Candidate{Cell: cellOf(idx), Value: d})
+		}
+	}
+
+	return eliminations
+}
+
+func cellsOf(indexes []int) []Cell {
+	cells := make([]Cell, len(indexes))
+	for i, idx := range indexes {
+		cells[i] = cellOf(idx)
+	}
+
+	return cells
+}
+
+func contains(indexes []int, idx int) bool {
+	for _, i := range indexes {
+		if i == idx {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (l *logic) hiddenSingle() (Step, bool) {
+	for _, u := range houseOrder {
+		for d := 1; d <= MaxValue; d++ {
+			cells := l.cellsWith(u, d)
+			if len(cells) != 1 {
+				continue
+			}
+
+			return Step{
+				Technique:  HiddenSingle,
+				Houses:     append([]House{houseOf(u)}, l.holding(u, d, cells[0])...),
+				Digits:     []int{d},
+				Cells:      cellsOf(cells),
+				Placements: []Candidate{{Cell: cellOf(cells[0]), Value: d}},
+			}, true
+		}
+	}
+
+	return Step{}, false
+}
+
+// holding returns the houses crossing the unit, which already hold the digit and so rule it out of the other empty
+// cells of the unit. The box is preferred, as it covers more cells of the line. It returns nil when any of the cells is
+// ruled out by an elimination only.
+func (l *logic) holding(u, d, target int) []House {
+	var chosen [3 * BoardSide]bool
+	for _, idx := range unitCells[u] {
+		if idx == target || l.values[idx] > 0 {
+			continue
+		}
+
+		crossing := [...]int{2*BoardSide + boxOf[idx], rowOf[idx], BoardSide + columnOf[idx]}
+		ruled := -1
+		for _, c := range crossing {
+			if c != u && chosen[c] {
+				ruled = c
+				break
+			}
+		}
+
+		for _, c := range crossing {
+			if ruled >= 0 {
+				break
+			}
+			for _, p := range unitCells[c] {
+				if c != u && l.values[p] == d {
+					ruled = c
+					break
+				}
+			}
+		}
+
+		if ruled < 0 {
+			return nil
+		}
+		chosen[ruled] = true
+	}
+
+	var houses []House
+	for c, ok := range chosen {
+		if ok {
+			houses = append(houses, houseOf(c))
+		}
+	}
+
+	return houses
+}
+
+// filling returns the row, column and box of the cell holding any value, when these values rule out all other
+// digits of the cell. It returns nil when any of the digits is ruled out by an elimination only.
+func (l *logic) filling(idx, d int) []House {
+	var seen uint16
+	for _, p := range peersOf[idx] {
+		if l.values[p] > 0 {
+			seen |= digitBit(l.values[p])
+		}
+	}
+
+	if seen|digitBit(d) != allDigits {
+		return nil
+	}
+
+	var houses []House
+	for _, u := range [...]int{rowOf[idx], BoardSide + columnOf[idx], 2*BoardSide + boxOf[idx]} {
+		for _, p := range unitCells[u] {
+			if p != idx && l.values[p] > 0 {
+				houses = append(houses, houseOf(u))
+				break
+			}
+		}
+	}
+
+	return houses
+}
+
+func (l *logic) nakedSingle() (Step, bool) {
+	for idx, c := range l.candidates {
+		if c == 0 || c&(c-1) != 0 {
+			continue
+		}
+
+		d := bits.TrailingZeros16(c)
+		return Step{
+			Technique:  NakedSingle,
+			Houses:     l.filling(idx, d),
+			Digits:     []int{d},
+			Cells:      []Cell{cellOf(idx)},
+			Placements: []Candidate{{Cell: cellOf(idx), Value: d}},
+		}, true
+	}
+
+	return Step{}, false
+}
+
+// pointing finds the digit, which is in the box limited to one row or column, so it can be removed from the rest of
+// the line.
+func (l *logic) pointing() (Step, bool) {
+	for box := 2 * BoardSide; box < 3*BoardSide; box++ {
+		for d := 1; d <= MaxValue; d++ {
+			cells := l.cellsWith(box, d)
+			if len(cells) < 2 {
+				continue
+			}
+
+			for _, line := range []int{rowOf[cells[0]], BoardSide + columnOf[cells[0]]} {
+				if s, ok := l.lockedCandidates(LockedCandidatesPointing, box, line, d, cells); ok {
+					return s, true
+				}
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// claiming finds the digit, which is in the row or column limited to one box, so it can be removed from the rest of
+// the box.
+func (l *logic) claiming() (Step, bool) {
+	for line := 0; line < 2*BoardSide; line++ {
+		for d := 1; d <= MaxValue; d++ {
+			cells := l.cellsWith(line, d)
+			if len(cells) < 2 {
+				continue
+			}
+
+			box := 2*BoardSide + boxOf[cells[0]]
+			if s, ok := l.lockedCandidates(LockedCandidatesClaiming, line, box, d, cells); ok {
+				return s, true
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// lockedCandidates checks that all cells of the base unit with the digit are in the cover unit as well, the digit is
+// then eliminated from the rest of the cover unit.
+func (l *logic) lockedCandidates(t Technique, base, cover, d int, cells []int) (Step, bool) {
+	for _, idx := range cells {
+		if !contains(unitCells[cover][:], idx) {
+			return Step{}, false
+		}
+	}
+
+	eliminations := l.eliminate(unitCells[cover][:], d, func(idx int) bool {
+		return contains(cells, idx)
+	})
+	if len(eliminations) == 0 {
+		return Step{}, false
+	}
+
+	return Step{
+		Technique:    t,
+		Houses:       []House{houseOf(base), houseOf(cover)},
+		Digits:       []int{d},
+		Cells:        cellsOf(cells),
+		Eliminations: eliminations,
+	}, true
+}
+
+// nakedSubset finds n cells of one unit with n candidates in total, these candidates can be removed from the rest of
+// the unit.
+func (l *logic) nakedSubset(t Technique, n int) (Step, bool) {
+	for _, u := range houseOrder {
+		var empty []int
+		for _, idx := range unitCells[u] {
+			if c := l.candidates[idx]; c != 0 && bits.OnesCount16(c) <= n {
+				empty = append(empty, idx)
+			}
+		}
+
+		var step Step
+		found := combinations(len(empty), n, func(combination []int) bool {
+			var union uint16
+			subset := make([]int, n)
+			for i, c := range combination {
+				subset[i] = empty[c]
+				union |= l.candidates[empty[c]]
+			}
+
+			if bits.OnesCount16(union) != n {
+				return false
+			}
+
+			var eliminations []Candidate
+			for _, d := range digitsOf(union) {
+				eliminations = append(eliminations, l.eliminate(unitCells[u][:], d, func(idx int) bool {
+					return contains(subset, idx)
+				})...)
+			}
+
+			if len(eliminations) == 0 {
+				return false
+			}
+
+			step = Step{
+				Technique:    t,
+				Houses:       []House{houseOf(u)},
+				Digits:       digitsOf(union),
+				Cells:        cellsOf(subset),
+				Eliminations: eliminations,
+			}
+			return true
+		})
+
+		if found {
+			return step, true
+		}
+	}
+
+	return Step{}, false
+}
+
+// hiddenSubset finds n digits of one unit, which fit into n cells only, all other candidates can be removed from
+// these cells.
+func (l *logic) hiddenSubset(t Technique, n int) (Step, bool) {
+	for _, u := range houseOrder {
+		var digits []int
+		for d := 1; d <= MaxValue; d++ {
+			if len(l.cellsWith(u, d)) > 0 {
+				digits = append(digits, d)
+			}
+		}
+
+		var step Step
+		found := combinations(len(digits), n, func(combination []int) bool {
+			var mask uint16
+			var subset []int
+			for _, c := range combination {
+				mask |= digitBit(digits[c])
+				for _, idx := range l.cellsWith(u, digits[c]) {
+					if !contains(subset, idx) {
+						subset = append(subset, idx)
+					}
+				}
+			}
+
+			if len(subset) != n {
+				return false
+			}
+
+			var eliminations []Candidate
+			for _, idx := range subset {
+				for _, d := range digitsOf(l.candidates[idx] &^ mask) {
+					eliminations = append(eliminations, Candidate{Cell: cellOf(idx), Value: d})
+				}
+			}
+
+			if len(eliminations) == 0 {
+				return false
+			}
+
+			step = Step{
+				Technique:    t,
+				Houses:       []House{houseOf(u)},
+				Digits:       digitsOf(mask),
+				Cells:        cellsOf(subset),
+				Eliminations: eliminations,
+			}
+			return true
+		})
+
+		if found {
+			return step, true
+		}
+	}
+
+	return Step{}, false
+}
+
+// fish finds n rows (or columns) where the digit fits into the same n columns (or rows) only, the digit can be removed
+// from the rest of these columns (or rows).
+func (l *logic) fish(t Technique, n int) (Step, bool) {
+	for d := 1; d <= MaxValue; d++ {
+		for _, kind := range []HouseKind{RowHouse, ColumnHouse} {
+			base := int(kind) * BoardSide
+			crossing := int(ColumnHouse-kind) * BoardSide // columns for rows and rows for columns
+
+			var lines []int
+			for u := base; u < base+BoardSide; u++ {
+				if c := len(l.cellsWith(u, d)); c >= 2 && c <= n {
+					lines = append(lines, u)
+				}
+			}
+
+			var step Step
+			found := combinations(len(lines), n, func(combination []int) bool {
+				var covers uint16
+				var cells []int
+				houses := make([]House, 0, 2*n)
+				for _, c := range combination {
+					houses = append(houses, houseOf(lines[c]))
+					for _, idx := range l.cellsWith(lines[c], d) {
+						cells = append(cells, idx)
+						if kind == RowHouse {
+							covers |= 1 << uint(columnOf[idx])
+						} else {
+							covers |= 1 << uint(rowOf[idx])
+						}
+					}
+				}
+
+				if bits.OnesCount16(covers) != n {
+					return false
+				}
+
+				var eliminations []Candidate
+				for i := 0; i < BoardSide; i++ {
+					if covers&(1<<uint(i)) == 0 {
+						continue
+					}
+
+					houses = append(houses, houseOf(crossing+i))
+					eliminations = append(eliminations, l.eliminate(unitCells[crossing+i][:], d, func(idx int) bool {
+						return contains(cells, idx)
+					})...)
+				}
+
+				if len(eliminations) == 0 {
+					return false
+				}
+
+				step = Step{
+					Technique:    t,
+					Houses:       houses,
+					Digits:       []int{d},
+					Cells:        cellsOf(cells),
+					Eliminations: eliminations,
+				}
+				return true
+			})
+
+			if found {
+				return step, true
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// xyWing finds the pivot cell with candidates xy and two pincers xz and yz seen by the pivot, the digit z can be
+// removed from all cells seen by both pincers.
+func (l *logic) xyWing() (Step, bool) {
+	for pivot, pc := range l.candidates {
+		if bits.OnesCount16(pc) != 2 {
+			continue
+		}
+
+		for _, a := range peersOf[pivot] {
+			ac := l.candidates[a]
+			if bits.OnesCount16(ac) != 2 || bits.OnesCount16(ac&pc) != 1 {
+				continue
+			}
+
+			z := ac &^ pc
+			for _, b := range peersOf[pivot] {
+				if b == a || l.candidates[b] != (pc&^ac)|z {
+					continue
+				}
+
+				if s, ok := l.wing(XYWing, []int{pivot, a, b}, pc|z, z, []int{a, b}); ok {
+					return s, true
+				}
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// xyzWing finds the pivot cell with candidates xyz and two pincers xz and yz seen by the pivot, the digit z can be
+// removed from all cells seen by the pivot and both pincers.
+func (l *logic) xyzWing() (Step, bool) {
+	for pivot, pc := range l.candidates {
+		if bits.OnesCount16(pc) != 3 {
+			continue
+		}
+
+		for _, a := range peersOf[pivot] {
+			ac := l.candidates[a]
+			if bits.OnesCount16(ac) != 2 || ac&^pc != 0 {
+				continue
+			}
+
+			for _, b := range peersOf[pivot] {
+				bc := l.candidates[b]
+				if b <= a || bits.OnesCount16(bc) != 2 || ac|bc != pc || ac == bc {
+					continue
+				}
+
+				if s, ok := l.wing(XYZWing, []int{pivot, a, b}, pc, ac&bc, []int{pivot, a, b}); ok {
+					return s, true
+				}
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// wing eliminates the digit z from all cells seen by every one of the seeing cells.
+func (l *logic) wing(t Technique, cells []int, digits, z uint16, seeing []int) (Step, bool) {
+	d := bits.TrailingZeros16(z)
+	var eliminations []Candidate
+	for _, idx := range peersOf[seeing[0]] {
+		if l.candidates[idx]&z == 0 || contains(cells, idx) {
+			continue
+		}
+
+		seen := true
+		for _, s := range seeing[1:] {
+			seen = seen && sees(idx, s)
+		}
+
+		if seen {
+			eliminations = append(eliminations, Candidate{Cell: cellOf(idx), Value: d})
+		}
+	}
+
+	if len(eliminations) == 0 {
+		return Step{}, false
+	}
+
+	return Step{
+		Technique:    t,
+		Digits:       digitsOf(digits),
+		Cells:        cellsOf(cells),
+		Eliminations: eliminations,
+	}, true
+}
+
+// simpleColouring connects the cells of the digit through the units with exactly two candidates (conjugate pairs) and
+// colours them by two alternating colours. When two cells of the same colour see each other, the colour is false.
+// A cell seeing both colours cannot hold the digit either.
+func (l *logic) simpleColouring() (Step, bool) {
+	for d := 1; d <= MaxValue; d++ {
+		var links [BoardSize][]int
+		for u := range unitCells {
+			if cells := l.cellsWith(u, d); len(cells) == 2 {
+				links[cells[0]] = append(links[cells[0]], cells[1])
+				links[cells[1]] = append(links[cells[1]], cells[0])
+			}
+		}
+
+		var colour [BoardSize]int // 0 not coloured, 1 and 2 are colours
+		for start := 0; start < BoardSize; start++ {
+			if len(links[start]) == 0 || colour[start] != 0 {
+				continue
+			}
+
+			chain, ok := colourChain(&links, &colour, start)
+			if !ok {
+				continue // odd cycle, the chain is not consistent
+			}
+
+			if s, ok := l.colourStep(d, chain, &colour); ok {
+				return s, true
+			}
+		}
+	}
+
+	return Step{}, false
+}
+
+// colourChain colours all cells connected to the start and returns them. It fails when two linked cells end up with
+// the same colour.
+func colourChain(links *[BoardSize][]int, colour *[BoardSize]int, start int) ([]int, bool) {
+	chain := []int{start}
+	colour[start] = 1
+	consistent := true
+	for i := 0; i < len(chain); i++ {
+		idx := chain[i]
+		for _, next := range links[idx] {
+			switch colour[next] {
+			case 0:
+				colour[next] = 3 - colour[idx]
+				chain = append(chain, next)
+			case colour[idx]:
+				consistent = false
+			}
+		}
+	}
+
+	return chain, consistent
+}
+
+func (l *logic) colourStep(d int, chain []int, colour *[BoardSize]int) (Step, bool) {
+	step := Step{
+		Technique: SimpleColouring,
+		Digits:    []int{d},
+		Cells:     cellsOf(chain),
+	}
+
+	// colour wrap - two cells of the same colour see each other
+	for _, a := range chain {
+		for _, b := range chain {
+			if a < b && colour[a] == colour[b] && sees(a, b) {
+				step.Eliminations = l.eliminate(chain, d, func(idx int) bool {
+					return colour[idx] != colour[a]
+				})
+				return step, true
+			}
+		}
+	}
+
+	// colour trap - a cell out of the chain sees both colours
+	for idx := 0; idx < BoardSize; idx++ {
+		if l.candidates[idx]&digitBit(d) == 0 || contains(chain, idx) {
+			continue
+		}
+
+		var seen int
+		for _, c := range chain {
+			if sees(idx, c) {
+				seen |= colour[c]
+			}
+		}
+
+		if seen == 3 {
+			step.Eliminations = append(step.Eliminations, Candidate{Cell: cellOf(idx), Value: d})
+		}
+	}
+
+	return step, len(step.Eliminations) > 0
+}
@@ -0,0 +1,174 @@
+package sudoku
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestBoard_SolveLogically(t *testing.T) {
+	g := singlesGame()
+	steps, err := g.SolveLogically()
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	for _, s := range steps {
+		if s.Technique != HiddenSingle && s.Technique != NakedSingle {
+			t.Errorf("newspaper puzzle should be solved by singles only, got: %v", s.Technique)
+		}
+	}
+
+	if !g.IsValid() || g.(*Board).emptyValueIndex() >= 0 {
+		t.Errorf("sudoku solved expected, got:\n%s", g)
+	}
+
+	for _, f := range []struct {
+		name     string
+		game     Game
+		solution Game
+	}{
+		{"easy", easyGame(), easyGameSolved()},
+		{"hard", hardGame(), hardGameSolved()},
+	} {
+		steps, err = f.game.SolveLogically()
+		if err != nil {
+			t.Errorf("%s: error not expected, got: %v", f.name, err)
+		}
+
+		checkSteps(t, f.name, steps, f.solution)
+		if !reflect.DeepEqual(f.game.Board(), f.solution.Board()) {
+			t.Errorf("%s: sudoku solved expected:\n%s, got:\n%s", f.name, f.solution, f.game)
+		}
+	}
+}
+
+func TestBoard_SolveLogicallyStuck(t *testing.T) {
+	g := guessingGame()
+	board := g.Board()
+
+	steps, err := g.SolveLogically()
+	if err != ErrNoLogicalStep {
+		t.Errorf("no logical step error expected, got: %v", err)
+	}
+
+	solution := guessingGame()
+	if err = solution.Solve(); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	checkSteps(t, "AI Escargot", steps, solution)
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("board should stay untouched when the techniques are not enough")
+	}
+
+	if _, err = NewBoard().SetValue(-1, 0, 1).SolveLogically(); err == nil {
+		t.Error("state error expected")
+	}
+
+	if _, err = easyGame().SetValue(3, 0, 9).SolveLogically(); err == nil {
+		t.Error("wrong input error expected for invalid board")
+	}
+}
+
+func TestTechnique_String(t *testing.T) {
+	if XYZWing.String() != "XYZ-Wing" {
+		t.Errorf("XYZ-Wing expected, got: %s", XYZWing)
+	}
+
+	if Technique(-1).String() != "Unknown Technique" || techniqueCount.String() != "Unknown Technique" {
+		t.Error("unknown technique expected for values out of range")
+	}
+}
+
+// checkSteps verifies that every placement agrees with the solution and no elimination removes the solution value
+func checkSteps(t *testing.T, name string, steps []Step, solution Game) {
+	t.Helper()
+	for _, s := range steps {
+		for _, p := range s.Placements {
+			if solution.Value(p.Row, p.Column) != p.Value {
+				t.Errorf("%s: %v placed a wrong value: %+v", name, s.Technique, p)
+			}
+		}
+
+		for _, e := range s.Eliminations {
+			if solution.Value(e.Row, e.Column) == e.Value {
+				t.Errorf("%s: %v eliminated the solution value: %+v", name, s.Technique, e)
+			}
+		}
+
+		if len(s.Placements)+len(s.Eliminations) == 0 {
+			t.Errorf("%s: %v made no progress", name, s.Technique)
+		}
+	}
+}
+
+func TestBoard_SolveLogicallySingleHouses(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		g, err := Generate(GenerateOptions{Rand: rand.New(rand.NewSource(seed))})
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		grid := g.(*Board).grid()
+		steps, _ := g.SolveLogically()
+		for _, s := range steps {
+			checkSingleHouses(t, grid, s)
+			for _, p := range s.Placements {
+				grid[p.Row*BoardSide+p.Column] = p.Value
+			}
+		}
+	}
+}
+
+// checkSingleHouses verifies that the houses added to the single rule out the rest of it by the values, and that they
+// are missing only when the values are not enough.
+func checkSingleHouses(t *testing.T, grid Grid, s Step) {
+	t.Helper()
+
+	holds := func(u int) uint16 {
+		var seen uint16
+		for _, p := range unitCells[u] {
+			if grid[p] > 0 {
+				seen |= digitBit(grid[p])
+			}
+		}
+		return seen
+	}
+
+	target := s.Cells[0].Row*BoardSide + s.Cells[0].Column
+	switch s.Technique {
+	case HiddenSingle:
+		for _, idx := range unitCells[unitOf(s.Houses[0])] {
+			if idx == target || grid[idx] > 0 || len(s.Houses) == 1 {
+				continue
+			}
+
+			ruled := false
+			for _, h := range s.Houses[1:] {
+				if contains(unitCells[unitOf(h)][:], idx) && holds(unitOf(h))&digitBit(s.Digits[0]) != 0 {
+					ruled = true
+				}
+			}
+
+			if !ruled {
+				t.Errorf("%v: cell %v not ruled out by houses %v", s, cellOf(idx), s.Houses)
+			}
+		}
+	case NakedSingle:
+		var seen, all uint16
+		for _, h := range s.Houses {
+			seen |= holds(unitOf(h))
+		}
+		for _, p := range peersOf[target] {
+			if grid[p] > 0 {
+				all |= digitBit(grid[p])
+			}
+		}
+
+		explained := all|digitBit(s.Digits[0]) == allDigits
+		if explained != (len(s.Houses) > 0) || (explained && seen|digitBit(s.Digits[0]) != allDigits) {
+			t.Errorf("%v: houses %v do not match the values", s, s.Houses)
+		}
+	}
+}
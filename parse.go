@@ -0,0 +1,93 @@
+package sudoku
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ParseError describes the position and the reason why the puzzle string cannot be parsed.
+type ParseError struct {
+	Line   int    // line of the input read by the Reader, zero for Parse
+	Offset int    // byte offset of the offending character in the input or the line, its length when it is too short
+	Char   rune   // offending character, zero when the input is too short
+	Reason string // description of the problem
+}
+
+// Error method returns the description of the parse error.
+func (e *ParseError) Error() string {
+	position := fmt.Sprintf("offset %d", e.Offset)
+	if e.Line > 0 {
+		position = fmt.Sprintf("line %d, offset %d", e.Line, e.Offset)
+	}
+
+	if e.Char == 0 {
+		return fmt.Sprintf("parse error at %s: %s", position, e.Reason)
+	}
+
+	return fmt.Sprintf("parse error at %s: %s %q", position, e.Reason, e.Char)
+}
+
+// Parse creates the Game from the 81 cells given row by row, where digits 1 - 9 are the values and any of the
+// characters '.', '0', '_' and '*' is the empty cell. Whitespace and the grid decoration '|', '-' and '+' are
+// ignored, so both the one line format and the drawn grid can be read. Clues colliding in any row, column or box are
+// rejected. The error is always *ParseError.
+func Parse(s string) (Game, error) {
+	b, err := parse(s, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// parse reads the cells by the rules of Parse, the colliding values are rejected only when strict is set.
+func parse(s string, strict bool) (*Board, error) {
+	b := NewBoard().(*Board)
+	idx := 0
+	for offset, r := range s {
+		var v uint
+		switch {
+		case r >= '1' && r <= '9':
+			v = uint(r - '0')
+		case isBlank(r):
+		case isDecoration(r):
+			continue
+		default:
+			if r == utf8.RuneError {
+				return nil, &ParseError{Offset: offset, Char: r, Reason: "invalid UTF-8 encoding"}
+			}
+			return nil, &ParseError{Offset: offset, Char: r, Reason: "unexpected character"}
+		}
+
+		if idx == BoardSize {
+			return nil, &ParseError{Offset: offset, Char: r, Reason: "too many cells"}
+		}
+
+		// the value collides with any earlier clue in its row, column or box
+		for _, p := range peersOf[idx] {
+			if strict && v > 0 && p < idx && b.b[p] == v {
+				return nil, &ParseError{Offset: offset, Char: r, Reason: "conflicting clue"}
+			}
+		}
+
+		b.b[idx] = v
+		idx++
+	}
+
+	if idx < BoardSize {
+		return nil, &ParseError{Offset: len(s), Reason: fmt.Sprintf("too few cells, got %d", idx)}
+	}
+
+	return b, nil
+}
+
+func isBlank(r rune) bool {
+	return r == '.' || r == '0' || r == '_' || r == '*'
+}
+
+func isDecoration(r rune) bool {
+	return unicode.IsSpace(r) || r == '|' || r == '-' || r == '+'
+}
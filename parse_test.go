@@ -0,0 +1,81 @@
+package sudoku
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	line := "..9748...7.........2.1.9.....7...24..64.1.59..98...3.....8.3.2.........6...2759.."
+	g, err := Parse(line)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if g.Value(0, 2) != 9 || g.Value(8, 6) != 9 || !g.IsEmpty(0, 0) || !g.IsEmpty(8, 8) {
+		t.Errorf("values of the puzzle expected, got:\n%s", g)
+	}
+
+	blanks := strings.Replace(line, ".", "0", 20)
+	blanks = blanks[:10] + "_*" + blanks[12:]
+	grid := `
+ 0 0 9 | 7 4 8 | . . .
+ 7 . . | . . . | . . .
+ . 2 . | 1 . 9 | . . .
+-------+-------+-------
+ . . 7 | . . . | 2 4 .
+ . 6 4 | . 1 . | 5 9 .
+ . 9 8 | . . . | 3 . .
+-------+-------+-------
+ . . . | 8 . 3 | . 2 .
+ . . . | . . . | . . 6
+ . . . | 2 7 5 | 9 . .
+`
+
+	for _, s := range []string{blanks, grid, "\t" + line + "\r\n"} {
+		other, err := Parse(s)
+		if err != nil {
+			t.Fatalf("error not expected, got: %v", err)
+		}
+
+		if !reflect.DeepEqual(other.Board(), g.Board()) {
+			t.Errorf("board expected:\n%s, got:\n%s", g, other)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	line := strings.Repeat(".", BoardSize)
+	tests := []struct {
+		input  string
+		offset int
+		char   rune
+	}{
+		{"x" + line, 0, 'x'},
+		{line[:40] + " | é", 43, 'é'},
+		{line + "1", BoardSize, '1'},
+		{line[:80] + "\xff", 80, '�'},
+		{line[:80], 80, 0},
+		{"", 0, 0},
+		{"11" + line[2:], 1, '1'},
+		{line[:10] + "5" + line[11:28] + "5" + line[29:], 28, '5'},
+	}
+
+	for _, tc := range tests {
+		_, err := Parse(tc.input)
+		pe, ok := err.(*ParseError)
+		if !ok {
+			t.Fatalf("parse error expected for %q, got: %v", tc.input, err)
+		}
+
+		if pe.Offset != tc.offset || pe.Char != tc.char {
+			t.Errorf("offset %d and character %q expected, got: %d and %q", tc.offset, tc.char, pe.Offset, pe.Char)
+		}
+	}
+
+	err := &ParseError{Offset: 3, Char: 'x', Reason: "unexpected character"}
+	if err.Error() != `parse error at offset 3: unexpected character 'x'` {
+		t.Errorf("error message expected, got: %s", err)
+	}
+}
@@ -0,0 +1,166 @@
+package sudoku
+
+import "errors"
+
+// ErrMultipleSolutions is returned when the puzzle has more than one solution, hence it cannot be rated.
+var ErrMultipleSolutions = errors.New("board has multiple solutions")
+
+// Difficulty is the difficulty level of the puzzle.
+type Difficulty int
+
+// Difficulty levels, the zero value means the puzzle is not rated.
+const (
+	Unrated Difficulty = iota
+	Easy
+	Medium
+	Hard
+	Expert
+	Diabolical
+)
+
+var difficultyNames = [...]string{"Unrated", "Easy", "Medium", "Hard", "Expert", "Diabolical"}
+
+// String method returns the name of the difficulty level.
+func (d Difficulty) String() string {
+	if d < Unrated || d > Diabolical {
+		return "Unknown Difficulty"
+	}
+
+	return difficultyNames[d]
+}
+
+// Scores of the techniques on the Sudoku Explainer scale. The hidden single in a box is easier than in a row or column,
+// hence it has its own score. The score of puzzles that cannot be solved by the implemented techniques is estimated
+// as the beginning of chains.
+const (
+	boxHiddenSingleScore = 1.2
+	beyondLogicScore     = 7.0
+)
+
+var techniqueScores = [techniqueCount]float64{
+	HiddenSingle:             1.5,
+	NakedSingle:              2.3,
+	LockedCandidatesPointing: 2.6,
+	LockedCandidatesClaiming: 2.8,
+	NakedPair:                3.0,
+	XWing:                    3.2,
+	HiddenPair:               3.4,
+	NakedTriple:              3.6,
+	Swordfish:                3.8,
+	HiddenTriple:             4.0,
+	XYWing:                   4.2,
+	XYZWing:                  4.4,
+	SimpleColouring:          4.5,
+	NakedQuad:                5.0,
+	Jellyfish:                5.2,
+	HiddenQuad:               5.4,
+}
+
+// Upper score limits of the difficulty levels, the Diabolical level has no limit.
+var difficultyLimits = [...]struct {
+	difficulty Difficulty
+	score      float64
+}{
+	{Easy, 2.0},
+	{Medium, 2.8},
+	{Hard, 3.8},
+	{Expert, 5.4},
+}
+
+// repeatedHardest is the number of uses of the hardest technique, which moves the puzzle one level up.
+const repeatedHardest = 3
+
+// Rating describes how hard the puzzle is for a human solver.
+type Rating struct {
+	Difficulty Difficulty        // difficulty level
+	Score      float64           // score of the hardest step on the Sudoku Explainer scale
+	Hardest    Technique         // hardest technique needed, valid only for puzzles solved by logic
+	Logical    bool              // whether the implemented techniques are enough to solve the puzzle
+	Counts     map[Technique]int // number of uses of each technique
+}
+
+// Rate rates the puzzle by the human techniques needed to solve it. The score equals to the score of the hardest step
+// only, so it stays comparable to Sudoku Explainer ratings, and the uses of techniques are reported by Counts without
+// changing the score. The difficulty level is given by the score, however the puzzle is moved one level up when the
+// hardest technique is used at least three times. Easy puzzles are never moved up, as their singles are always
+// repeated. Puzzles that cannot be solved by the implemented techniques are always Diabolical. The puzzle has to have
+// exactly one solution.
+func Rate(g Game) (Rating, error) {
+	if err := g.Error(); err != nil {
+		return Rating{}, err
+	}
+
+	grid := NewBoard().(*Board)
+	for r, row := range g.Board() {
+		grid.SetRow(r, row)
+	}
+
+	count, err := grid.CountSolutions(2)
+	if err != nil {
+		return Rating{}, err
+	}
+
+	switch count {
+	case 0:
+		return Rating{}, ErrNoSolution
+	case 2:
+		return Rating{}, ErrMultipleSolutions
+	}
+
+	steps, err := newLogic(grid.grid()).solve()
+	if err != nil && err != ErrNoLogicalStep {
+		return Rating{}, err
+	}
+
+	return rateSteps(steps, err == nil), nil
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+func rateSteps(steps []Step, logical bool) Rating {
+	r := Rating{
+		Logical: logical,
+		Counts:  make(map[Technique]int),
+	}
+
+	hardestUses := 0
+	for _, s := range steps {
+		r.Counts[s.Technique]++
+
+		score := stepScore(s)
+		switch {
+		case score > r.Score:
+			r.Score, r.Hardest, hardestUses = score, s.Technique, 1
+		case score == r.Score:
+			hardestUses++
+		}
+	}
+
+	if !logical {
+		r.Score = beyondLogicScore
+		r.Difficulty = Diabolical
+		return r
+	}
+
+	r.Difficulty = Diabolical
+	for _, l := range difficultyLimits {
+		if r.Score <= l.score {
+			r.Difficulty = l.difficulty
+			break
+		}
+	}
+
+	if hardestUses >= repeatedHardest && r.Difficulty < Diabolical && r.Difficulty > Easy {
+		r.Difficulty++
+	}
+
+	return r
+}
+
+func stepScore(s Step) float64 {
+	if s.Technique == HiddenSingle && len(s.Houses) > 0 && s.Houses[0].Kind == BoxHouse {
+		return boxHiddenSingleScore
+	}
+
+	return techniqueScores[s.Technique]
+}
@@ -0,0 +1,90 @@
+package sudoku
+
+import "testing"
+
+func TestRate(t *testing.T) {
+	tests := []struct {
+		name       string
+		game       Game
+		difficulty Difficulty
+		score      float64
+		hardest    Technique
+		logical    bool
+	}{
+		{"singles", singlesGame(), Easy, 1.2, HiddenSingle, true},
+		{"easy", easyGame(), Hard, 3.4, HiddenPair, true},
+		{"hard", hardGame(), Hard, 3.2, XWing, true},
+		{"guessing", guessingGame(), Diabolical, beyondLogicScore, HiddenSingle, false},
+	}
+
+	for _, tc := range tests {
+		r, err := Rate(tc.game)
+		if err != nil {
+			t.Fatalf("%s: error not expected, got: %v", tc.name, err)
+		}
+
+		if r.Difficulty != tc.difficulty || r.Score != tc.score || r.Logical != tc.logical {
+			t.Errorf("%s: %v %.1f (logical %t) expected, got: %v %.1f (logical %t)",
+				tc.name, tc.difficulty, tc.score, tc.logical, r.Difficulty, r.Score, r.Logical)
+		}
+
+		if r.Logical && r.Hardest != tc.hardest {
+			t.Errorf("%s: hardest technique %v expected, got: %v", tc.name, tc.hardest, r.Hardest)
+		}
+
+		if r.Counts[HiddenSingle] == 0 {
+			t.Errorf("%s: hidden singles expected in counts, got: %v", tc.name, r.Counts)
+		}
+	}
+}
+
+func TestRate_Errors(t *testing.T) {
+	if _, err := Rate(ambiguousGame()); err != ErrMultipleSolutions {
+		t.Errorf("multiple solutions error expected, got: %v", err)
+	}
+
+	if _, err := Rate(noSolutionGame()); err != ErrNoSolution {
+		t.Errorf("no solution error expected, got: %v", err)
+	}
+
+	if _, err := Rate(NewBoard().SetValue(0, 0, 1).SetValue(0, 1, 1)); err != ErrWrongInput {
+		t.Errorf("wrong input error expected, got: %v", err)
+	}
+
+	if _, err := Rate(NewBoard().SetValue(BoardSide, 0, 1)); err != errOutOfBoardIndex {
+		t.Errorf("out of board index error expected, got: %v", err)
+	}
+}
+
+func TestRateSteps(t *testing.T) {
+	steps := []Step{
+		{Technique: HiddenSingle, Houses: []House{{Kind: RowHouse}}},
+		{Technique: NakedPair},
+		{Technique: NakedPair},
+		{Technique: NakedPair},
+	}
+
+	r := rateSteps(steps, true)
+	if r.Score != 3.0 || r.Hardest != NakedPair || r.Counts[NakedPair] != 3 {
+		t.Errorf("naked pair with score 3.0 used three times expected, got: %+v", r)
+	}
+
+	// the repeated hardest technique moves the puzzle one level up
+	if r.Difficulty != Expert {
+		t.Errorf("%v expected, got: %v", Expert, r.Difficulty)
+	}
+
+	if r = rateSteps(steps[:2], true); r.Difficulty != Hard {
+		t.Errorf("%v expected, got: %v", Hard, r.Difficulty)
+	}
+}
+
+func TestDifficulty_String(t *testing.T) {
+	if Diabolical.String() != "Diabolical" || Unrated.String() != "Unrated" {
+		t.Errorf("names of difficulties expected, got: %s, %s", Diabolical, Unrated)
+	}
+
+	if Difficulty(-1).String() != "Unknown Difficulty" {
+		t.Errorf("unknown difficulty expected, got: %s", Difficulty(-1))
+	}
+}
@@ -0,0 +1,185 @@
+package sudoku
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Border is the style of lines drawn around cells and boxes.
+type Border int
+
+// Border styles, thick lines always separate the 3x3 boxes.
+const (
+	UnicodeBorder Border = iota // box drawing characters, such as ║ and │
+	ASCIIBorder                 // plain characters, where '=' and '|' are the thick lines, '-' and ':' the thin ones
+)
+
+// RenderOptions configures the Render method. The zero value draws the Unicode grid with spaces in empty cells.
+type RenderOptions struct {
+	Border      Border // style of lines
+	Blank       rune   // character of empty cells, zero means the space
+	Labels      bool   // whether rows and columns are numbered from 1 to 9
+	PencilMarks bool   // whether pencil marks are drawn as mini 3x3 grids inside empty cells
+}
+
+// Render method returns the board drawn by the options, where every cell is framed and thick lines separate boxes.
+// The method String keeps the original simple layout.
+func (b Board) Render(opts RenderOptions) string {
+	st := borderStyles[ASCIIBorder]
+	if opts.Border != ASCIIBorder {
+		st = borderStyles[UnicodeBorder]
+	}
+
+	blank := opts.Blank
+	if blank == 0 {
+		blank = ' '
+	}
+
+	r := renderer{b: b, style: st, blank: blank, width: 3, height: 1, labels: opts.Labels, pencil: opts.PencilMarks}
+	if r.pencil {
+		r.width, r.height = 5, 3
+	}
+
+	return r.render()
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// lineStyle keeps characters of one horizontal line: the left end, the fill, the crossing with the thin and the thick
+// vertical line and the right end.
+type lineStyle [5]rune
+
+// borderStyle keeps characters of all lines, the row is the line with cells, where the fill is not used.
+type borderStyle struct {
+	top, thin, thick, bottom, row lineStyle
+}
+
+var borderStyles = [...]borderStyle{
+	UnicodeBorder: {
+		top:    lineStyle{'╔', '═', '╤', '╦', '╗'},
+		thin:   lineStyle{'╟', '─', '┼', '╫', '╢'},
+		thick:  lineStyle{'╠', '═', '╪', '╬', '╣'},
+		bottom: lineStyle{'╚', '═', '╧', '╩', '╝'},
+		row:    lineStyle{'║', ' ', '│', '║', '║'},
+	},
+	ASCIIBorder: {
+		top:    lineStyle{'+', '=', '=', '+', '+'},
+		thin:   lineStyle{'|', '-', '+', '|', '|'},
+		thick:  lineStyle{'+', '=', '=', '+', '+'},
+		bottom: lineStyle{'+', '=', '=', '+', '+'},
+		row:    lineStyle{'|', ' ', ':', '|', '|'},
+	},
+}
+
+type renderer struct {
+	b             Board
+	style         borderStyle
+	blank         rune
+	width, height int // size of the cell inside its frame
+	labels        bool
+	pencil        bool
+	sb            strings.Builder
+}
+
+func (r *renderer) render() string {
+	if r.labels {
+		header := "   "
+		for c := 0; c < BoardSide; c++ {
+			header += r.center(strconv.Itoa(c+1)) + " "
+		}
+		r.sb.WriteString(strings.TrimRight(header, " ") + "\n")
+	}
+
+	for row := 0; row < BoardSide; row++ {
+		switch {
+		case row == 0:
+			r.line(r.style.top)
+		case row%BoardBoxSize == 0:
+			r.line(r.style.thick)
+		default:
+			r.line(r.style.thin)
+		}
+
+		for i := 0; i < r.height; i++ {
+			label := ""
+			if i == r.height/2 {
+				label = strconv.Itoa(row + 1)
+			}
+			r.prefix(label)
+
+			r.sb.WriteRune(r.style.row[0])
+			for c := 0; c < BoardSide; c++ {
+				r.sb.WriteString(r.cell(row*BoardSide+c, i))
+				r.separator(c, r.style.row)
+			}
+			r.sb.WriteByte('\n')
+		}
+	}
+	r.line(r.style.bottom)
+
+	return r.sb.String()
+}
+
+func (r *renderer) line(ls lineStyle) {
+	r.prefix("")
+	r.sb.WriteRune(ls[0])
+	for c := 0; c < BoardSide; c++ {
+		r.sb.WriteString(strings.Repeat(string(ls[1]), r.width))
+		r.separator(c, ls)
+	}
+	r.sb.WriteByte('\n')
+}
+
+// separator writes the character following the column: the thin or thick line or the right end.
+func (r *renderer) separator(column int, ls lineStyle) {
+	switch {
+	case column == BoardSide-1:
+		r.sb.WriteRune(ls[4])
+	case column%BoardBoxSize == BoardBoxSize-1:
+		r.sb.WriteRune(ls[3])
+	default:
+		r.sb.WriteRune(ls[2])
+	}
+}
+
+func (r *renderer) prefix(label string) {
+	if r.labels {
+		r.sb.WriteString(label + strings.Repeat(" ", 2-len(label)))
+	}
+}
+
+// cell returns the i-th line of the cell content.
+func (r *renderer) cell(idx, i int) string {
+	v, marks := r.b.b[idx], r.b.c[idx]
+	if !r.pencil {
+		marks = 0
+	}
+
+	switch {
+	case v > 0 && i == r.height/2:
+		return r.center(strconv.Itoa(int(v)))
+	case v > 0:
+		return r.center(" ")
+	case marks == 0 && i == r.height/2:
+		return r.center(string(r.blank))
+	case marks == 0:
+		return r.center(" ")
+	}
+
+	// mini grid of pencil marks, the line i keeps digits 3i+1 to 3i+3
+	mini := []byte("   ")
+	for d := 0; d < BoardBoxSize; d++ {
+		if digit := i*BoardBoxSize + d + 1; marks&digitBit(digit) != 0 {
+			mini[d] = byte('0' + digit)
+		}
+	}
+
+	return r.center(string(mini))
+}
+
+// center pads the text of one or three characters to the width of the cell.
+func (r *renderer) center(text string) string {
+	n := len([]rune(text))
+	left := (r.width - n) / 2
+	return strings.Repeat(" ", left) + text + strings.Repeat(" ", r.width-n-left)
+}
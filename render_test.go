@@ -0,0 +1,63 @@
+package sudoku
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBoard_Render(t *testing.T) {
+	g := easyGame().SetCandidates(0, 0, []int{2, 5, 9})
+
+	expected := `    1   2   3   4   5   6   7   8   9
+  +===========+===========+===========+
+1 | . : . : . | . : . : . | 1 : 4 : 8 |
+  |---+---+---|---+---+---|---+---+---|
+2 | . : 1 : . | . : 2 : 6 | . : . : 3 |
+  |---+---+---|---+---+---|---+---+---|
+3 | . : . : . | . : 1 : . | 6 : . : . |
+  +===========+===========+===========+
+4 | . : . : . | . : . : . | 9 : . : 2 |
+  |---+---+---|---+---+---|---+---+---|
+5 | 1 : . : . | 3 : 6 : 2 | . : . : 7 |
+  |---+---+---|---+---+---|---+---+---|
+6 | 5 : . : 7 | . : . : . | . : . : . |
+  +===========+===========+===========+
+7 | . : . : 5 | . : 3 : . | . : . : . |
+  |---+---+---|---+---+---|---+---+---|
+8 | 3 : . : . | 1 : 9 : . | . : 7 : . |
+  |---+---+---|---+---+---|---+---+---|
+9 | 4 : 7 : . | . : . : . | . : . : . |
+  +===========+===========+===========+
+`
+
+	if s := g.Render(RenderOptions{Border: ASCIIBorder, Blank: '.', Labels: true}); s != expected {
+		t.Errorf("ASCII grid expected:\n%s, got:\n%s", expected, s)
+	}
+
+	lines := strings.Split(g.Render(RenderOptions{}), "\n")
+	top := []string{
+		"╔═══╤═══╤═══╦═══╤═══╤═══╦═══╤═══╤═══╗",
+		"║   │   │   ║   │   │   ║ 1 │ 4 │ 8 ║",
+		"╟───┼───┼───╫───┼───┼───╫───┼───┼───╢",
+	}
+
+	if len(lines) != 2*BoardSide+2 || strings.Join(lines[:3], "\n") != strings.Join(top, "\n") {
+		t.Errorf("Unicode grid expected, got:\n%s", strings.Join(lines, "\n"))
+	}
+
+	if lines[6] != "╠═══╪═══╪═══╬═══╪═══╪═══╬═══╪═══╪═══╣" || lines[18] != "╚═══╧═══╧═══╩═══╧═══╧═══╩═══╧═══╧═══╝" {
+		t.Errorf("thick lines between boxes expected, got:\n%s\n%s", lines[6], lines[18])
+	}
+
+	// pencil marks of the first cell as the mini grid
+	lines = strings.Split(g.Render(RenderOptions{PencilMarks: true}), "\n")
+	cell := []string{
+		"║  2  │     │     ║     │     │     ║     │     │     ║",
+		"║  5  │     │     ║     │     │     ║  1  │  4  │  8  ║",
+		"║   9 │     │     ║     │     │     ║     │     │     ║",
+	}
+
+	if len(lines) != 4*BoardSide+2 || strings.Join(lines[1:4], "\n") != strings.Join(cell, "\n") {
+		t.Errorf("pencil marks expected:\n%s\ngot:\n%s", strings.Join(cell, "\n"), strings.Join(lines, "\n"))
+	}
+}
@@ -0,0 +1,511 @@
+package sudoku
+
+import (
+	"context"
+	"errors"
+	"math/bits"
+	"time"
+)
+
+// ErrSearchLimit is returned when the search visits the maximal number of nodes before it is finished.
+var ErrSearchLimit = errors.New("search limit exceeded")
+
+// Grid is the plain representation of the board values passed to the solvers. The values are stored row by row and
+// zero means an empty cell.
+type Grid [BoardSize]int
+
+// Solver is the engine searching for the solutions of the grid. Implementations can be used by the Board through the
+// option WithSolver or by replacing the DefaultSolver.
+type Solver interface {
+	// Search calls found for every solution of the grid until found returns false or there are no more solutions.
+	// The returned error is either ErrSearchLimit or the context error, when the search has been interrupted.
+	Search(ctx context.Context, g Grid, found func(Grid) bool) (SolveStats, error)
+}
+
+// SolveStats describes the work done by the solver. The numbers of propagated and guessed cells belong to the path
+// leading to the first solution, so together they give the number of empty cells of the solved grid.
+type SolveStats struct {
+	Nodes      int           // number of search nodes, one node is one tried value
+	Backtracks int           // number of dead ends, where the search had to step back
+	MaxDepth   int           // maximal number of guesses on top of each other
+	Propagated int           // number of cells filled by propagation (forced values)
+	Guessed    int           // number of cells filled by guessing
+	Elapsed    time.Duration // wall time of the search
+}
+
+// DefaultSolver is the solver used by the Board, when there is no other one chosen by the option WithSolver.
+var DefaultSolver Solver = Backtracking{}
+
+// SolveGrid returns the first solution of the grid found by the solver. When there is no solution ErrNoSolution is
+// returned.
+func SolveGrid(ctx context.Context, s Solver, g Grid) (Grid, SolveStats, error) {
+	var solution Grid
+	solved := false
+	stats, err := s.Search(ctx, g, func(found Grid) bool {
+		solution = found
+		solved = true
+		return false // the first solution is enough
+	})
+
+	if err != nil {
+		return g, stats, err
+	}
+
+	if !solved {
+		return g, stats, ErrNoSolution
+	}
+
+	return solution, stats, nil
+}
+
+// SolveOption configures the search used by the solving methods.
+type SolveOption func(*solveConfig)
+
+// CellSelection is the strategy how the search chooses the next empty cell to branch on.
+type CellSelection int
+
+// Strategies of choosing the next empty cell.
+const (
+	SelectFewestCandidates CellSelection = iota // the empty cell with the fewest candidates, the default one
+	SelectLastEmpty                             // the last empty cell on the board regardless of its candidates
+)
+
+// solveConfig keeps the search settings, only the settings marked as set override the solver ones.
+type solveConfig struct {
+	maxNodes     int
+	hasMaxNodes  bool
+	selection    CellSelection
+	hasSelection bool
+	solver       Solver
+}
+
+// WithMaxNodes option limits the search of the built-in solvers to the maximal number of nodes, where one node is one
+// value tried in an empty cell. Zero or negative value means there is no limit.
+func WithMaxNodes(n int) SolveOption {
+	return func(c *solveConfig) {
+		c.maxNodes = n
+		c.hasMaxNodes = true
+	}
+}
+
+// WithCellSelection option sets the strategy of choosing the next empty cell, when the Backtracking solver is used.
+func WithCellSelection(s CellSelection) SolveOption {
+	return func(c *solveConfig) {
+		c.selection = s
+		c.hasSelection = true
+	}
+}
+
+// WithSolver option replaces the DefaultSolver with the given solver. Custom solvers are used as they are, hence the
+// options WithMaxNodes and WithCellSelection have no effect on them.
+func WithSolver(s Solver) SolveOption {
+	return func(c *solveConfig) {
+		c.solver = s
+	}
+}
+
+func newSolveConfig(opts []SolveOption) solveConfig {
+	c := solveConfig{}
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	return c
+}
+
+// engine returns the solver to use with the options applied to the built-in solvers.
+func (c solveConfig) engine() Solver {
+	s := c.solver
+	if s == nil {
+		s = DefaultSolver
+	}
+
+	switch e := s.(type) {
+	case Backtracking:
+		if c.hasMaxNodes {
+			e.MaxNodes = c.maxNodes
+		}
+		if c.hasSelection {
+			e.Selection = c.selection
+		}
+		return e
+	case DancingLinks:
+		if c.hasMaxNodes {
+			e.MaxNodes = c.maxNodes
+		}
+		return e
+	default:
+		return s
+	}
+}
+
+// Backtracking is the depth-first search Solver, which tries all candidates of one empty cell after another. Before
+// every guess it places naked and hidden singles, so simple puzzles are solved without any guessing.
+type Backtracking struct {
+	MaxNodes  int           // maximal number of search nodes, zero means no limit
+	Selection CellSelection // strategy of choosing the next empty cell
+}
+
+// Search calls found for every solution of the grid until found returns false or there are no more solutions.
+func (bt Backtracking) Search(ctx context.Context, g Grid, found func(Grid) bool) (SolveStats, error) {
+	b, err := g.board()
+	if err != nil {
+		return SolveStats{}, err
+	}
+
+	start := time.Now()
+	s := newSearcher(ctx, b, solveConfig{maxNodes: bt.MaxNodes, selection: bt.Selection})
+	err = s.run(func() bool {
+		s.solved(s.trailLen, s.depth)
+		return found(b.grid())
+	})
+
+	s.stats.Elapsed = time.Since(start)
+	return s.stats, err
+}
+
+// board creates the Board with the grid values, which has to be valid.
+func (g Grid) board() (*Board, error) {
+	b := NewBoard().(*Board)
+	for idx, v := range g {
+		if v < 0 || v > MaxValue {
+			return nil, ErrWrongInput
+		}
+		b.b[idx] = uint(v)
+	}
+
+	if !b.IsValid() {
+		return nil, ErrWrongInput
+	}
+
+	return b, nil
+}
+
+// limiter collects the search statistics and stops the search when the context is done or the node limit is reached.
+type limiter struct {
+	ctx      context.Context
+	maxNodes int
+	depth    int
+	found    bool
+	stats    SolveStats
+	err      error
+}
+
+// push goes one guess deeper.
+func (l *limiter) push() {
+	l.depth++
+	if l.depth > l.stats.MaxDepth {
+		l.stats.MaxDepth = l.depth
+	}
+}
+
+// pop steps back from the last guess.
+func (l *limiter) pop() {
+	l.depth--
+}
+
+// solved records how the cells of the first solution have been filled.
+func (l *limiter) solved(propagated, guessed int) {
+	if l.found {
+		return
+	}
+
+	l.found = true
+	l.stats.Propagated = propagated
+	l.stats.Guessed = guessed
+}
+
+// next counts the visited node and reports whether the search can continue.
+func (l *limiter) next() bool {
+	l.stats.Nodes++
+	if l.maxNodes > 0 && l.stats.Nodes > l.maxNodes {
+		l.err = ErrSearchLimit
+		return false
+	}
+
+	return !l.done()
+}
+
+// done reports whether the context is done, its error is kept to be returned by the search.
+func (l *limiter) done() bool {
+	select {
+	case <-l.ctx.Done():
+		l.err = l.ctx.Err()
+		return true
+	default:
+		return false
+	}
+}
+
+// allDigits is the bitmask of all digits, the digit v is represented by the bit 1 << v.
+const allDigits = uint16(1<<(MaxValue+1) - 2)
+
+// Precomputed positions of each cell, so the search loop doesn't need to compute them.
+var rowOf, columnOf, boxOf = cellUnits()
+
+// unitCells keeps the cell indexes of all units - rows first, then columns and boxes.
+var unitCells = unitIndexes()
+
+func cellUnits() (rows, columns, boxes [BoardSize]int) {
+	for idx := 0; idx < BoardSize; idx++ {
+		rows[idx] = idx / BoardSide
+		columns[idx] = idx % BoardSide
+		boxes[idx] = (rows[idx]/BoardBoxSize)*BoardBoxSize + columns[idx]/BoardBoxSize
+	}
+
+	return rows, columns, boxes
+}
+
+func unitIndexes() (units [3 * BoardSide][BoardSide]int) {
+	var filled [3 * BoardSide]int
+	for idx := 0; idx < BoardSize; idx++ {
+		for _, u := range []int{rowOf[idx], BoardSide + columnOf[idx], 2*BoardSide + boxOf[idx]} {
+			units[u][filled[u]] = idx
+			filled[u]++
+		}
+	}
+
+	return units
+}
+
+// searcher is the backtracking search over the board values, which respects the context and the node limit.
+// Digits used in every row, column and box are kept as bitmasks, which are updated with each placed or removed value.
+// Before each guess the naked and hidden singles are placed, the trail keeps them to be removed when backtracking.
+type searcher struct {
+	limiter
+	b         *Board
+	rows      [BoardSide]uint16
+	columns   [BoardSide]uint16
+	boxes     [BoardSide]uint16
+	trail     [BoardSize]int
+	trailLen  int
+	selection CellSelection
+}
+
+// newSearcher creates the searcher for the board, which has to be valid.
+func newSearcher(ctx context.Context, b *Board, c solveConfig) *searcher {
+	s := &searcher{
+		limiter:   limiter{ctx: ctx, maxNodes: c.maxNodes},
+		b:         b,
+		selection: c.selection,
+	}
+
+	for idx, v := range b.b {
+		if v > 0 {
+			s.set(idx, uint16(1)<<v)
+		}
+	}
+
+	return s
+}
+
+// run walks through all solutions of the board and calls found for each of them. The search continues until found
+// returns false or there are no more solutions. The board is always left in its original state. The returned error is
+// either ErrSearchLimit or the context error, when the search has been interrupted.
+func (s *searcher) run(found func() bool) error {
+	if s.done() {
+		return s.err
+	}
+
+	s.search(found)
+	return s.err
+}
+
+func (s *searcher) search(found func() bool) bool {
+	mark := s.trailLen
+	ok := s.branch(found)
+	s.undo(mark)
+
+	return ok
+}
+
+func (s *searcher) branch(found func() bool) bool {
+	if !s.propagate() {
+		if s.err != nil {
+			return false
+		}
+
+		s.stats.Backtracks++
+		return true
+	}
+
+	idx, candidates := s.nextCell()
+	if idx < 0 {
+		return found()
+	}
+
+	if candidates == 0 {
+		s.stats.Backtracks++
+		return true
+	}
+
+	for v := uint(1); v <= MaxValue; v++ {
+		bit := uint16(1) << v
+		if candidates&bit == 0 {
+			continue
+		}
+
+		if !s.next() {
+			return false
+		}
+
+		s.b.b[idx] = v
+		s.set(idx, bit)
+		s.push()
+		ok := s.search(found)
+		s.pop()
+		s.clear(idx, bit)
+		s.b.b[idx] = 0 // empty value
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// propagate places naked singles (the only candidate of a cell) and hidden singles (the only cell of a unit where
+// a digit fits) until there is nothing else to place. It returns false when there is a contradiction - a cell
+// without any candidate or a digit without any place in a unit, or when the context is done.
+func (s *searcher) propagate() bool {
+	for changed := true; changed; {
+		changed = false
+		if s.done() {
+			return false
+		}
+
+		for idx, v := range s.b.b {
+			if v > 0 {
+				continue
+			}
+
+			candidates := s.candidates(idx)
+			if candidates == 0 {
+				return false
+			}
+
+			if candidates&(candidates-1) == 0 {
+				s.place(idx, candidates)
+				changed = true
+			}
+		}
+
+		for u := range unitCells {
+			placed, ok := s.hiddenSingles(u)
+			if !ok {
+				return false
+			}
+			changed = changed || placed
+		}
+	}
+
+	return true
+}
+
+// hiddenSingles places all digits that fit into only one cell of the unit. It reports whether any digit has been
+// placed and false as the second value when a missing digit has no cell.
+func (s *searcher) hiddenSingles(u int) (bool, bool) {
+	var once, twice, used uint16
+	for _, idx := range unitCells[u] {
+		if s.b.b[idx] > 0 {
+			used |= uint16(1) << s.b.b[idx]
+			continue
+		}
+
+		candidates := s.candidates(idx)
+		twice |= once & candidates
+		once |= candidates
+	}
+
+	if (once|used)&allDigits != allDigits {
+		return false, false
+	}
+
+	singles := once &^ twice &^ used
+	if singles == 0 {
+		return false, true
+	}
+
+	for _, idx := range unitCells[u] {
+		if s.b.b[idx] > 0 {
+			continue
+		}
+
+		if bit := s.candidates(idx) & singles; bit != 0 {
+			// two hidden singles in one cell is a contradiction found by the next round
+			bit &= -bit
+			s.place(idx, bit)
+			singles &^= bit
+		}
+	}
+
+	return true, true
+}
+
+// place puts the digit into the cell and remembers it in the trail.
+func (s *searcher) place(idx int, bit uint16) {
+	s.b.b[idx] = uint(bits.TrailingZeros16(bit))
+	s.set(idx, bit)
+	s.trail[s.trailLen] = idx
+	s.trailLen++
+}
+
+// undo removes all digits placed since the trail had the given length.
+func (s *searcher) undo(mark int) {
+	for s.trailLen > mark {
+		s.trailLen--
+		idx := s.trail[s.trailLen]
+		s.clear(idx, uint16(1)<<s.b.b[idx])
+		s.b.b[idx] = 0 // empty value
+	}
+}
+
+// nextCell returns the empty cell to branch on together with its candidates. When there is no empty cell the index
+// is negative.
+func (s *searcher) nextCell() (int, uint16) {
+	if s.selection == SelectLastEmpty {
+		idx := s.b.emptyValueIndex()
+		if idx < 0 {
+			return idx, 0
+		}
+
+		return idx, s.candidates(idx)
+	}
+
+	best, bestCandidates, bestCount := -1, uint16(0), MaxValue+1
+	for idx, v := range s.b.b {
+		if v > 0 {
+			continue
+		}
+
+		candidates := s.candidates(idx)
+		count := bits.OnesCount16(candidates)
+		if count < bestCount {
+			best, bestCandidates, bestCount = idx, candidates, count
+			// no candidate is a dead end and one candidate cannot be beaten
+			if count <= 1 {
+				break
+			}
+		}
+	}
+
+	return best, bestCandidates
+}
+
+// candidates returns the bitmask of digits that can be placed into the cell.
+func (s *searcher) candidates(idx int) uint16 {
+	return ^(s.rows[rowOf[idx]] | s.columns[columnOf[idx]] | s.boxes[boxOf[idx]]) & allDigits
+}
+
+func (s *searcher) set(idx int, bit uint16) {
+	s.rows[rowOf[idx]] |= bit
+	s.columns[columnOf[idx]] |= bit
+	s.boxes[boxOf[idx]] |= bit
+}
+
+func (s *searcher) clear(idx int, bit uint16) {
+	s.rows[rowOf[idx]] &^= bit
+	s.columns[columnOf[idx]] &^= bit
+	s.boxes[boxOf[idx]] &^= bit
+}
@@ -0,0 +1,189 @@
+package sudoku
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSolveGrid(t *testing.T) {
+	g := hardGame().(*Board).grid()
+	s := hardGameSolved().(*Board).grid()
+
+	for _, solver := range []Solver{Backtracking{}, DancingLinks{}} {
+		solution, stats, err := SolveGrid(context.Background(), solver, g)
+		if err != nil {
+			t.Errorf("%T: error not expected, got: %v", solver, err)
+		}
+
+		if solution != s {
+			t.Errorf("%T: solution expected: %v, got: %v", solver, s, solution)
+		}
+
+		if _, ok := solver.(DancingLinks); ok && stats.Nodes < 1 {
+			t.Errorf("%T: visited nodes expected, got: %+v", solver, stats)
+		}
+
+		_, _, err = SolveGrid(context.Background(), solver, noSolutionGame().(*Board).grid())
+		if err != ErrNoSolution {
+			t.Errorf("%T: no solution error expected, got: %v", solver, err)
+		}
+	}
+}
+
+// stubSolver returns the fixed solution, so it is clear the Board delegates the search to it
+type stubSolver struct {
+	solution Grid
+}
+
+func (s stubSolver) Search(_ context.Context, _ Grid, found func(Grid) bool) (SolveStats, error) {
+	found(s.solution)
+	return SolveStats{Nodes: 1}, nil
+}
+
+func TestBacktracking_Search(t *testing.T) {
+	// singles are enough for the newspaper puzzle, there is no need to guess
+	_, stats, err := SolveGrid(context.Background(), Backtracking{}, singlesGame().(*Board).grid())
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if stats.Nodes != 0 || stats.Backtracks != 0 {
+		t.Errorf("newspaper puzzle should be solved without guessing, got: %+v", stats)
+	}
+
+	_, stats, err = SolveGrid(context.Background(), Backtracking{}, guessingGame().(*Board).grid())
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if stats.Nodes < 1 {
+		t.Errorf("guessing expected for the AI Escargot, got: %+v", stats)
+	}
+
+	// the singles in the propagation have to be undone, so the other solutions are found
+	count, err := ambiguousGame().CountSolutions(1000)
+	if err != nil || count != 104 {
+		t.Errorf("104 solutions of the ambiguous game expected, got: %d, %v", count, err)
+	}
+}
+
+func TestWithSolver(t *testing.T) {
+	solved := hardGameSolved()
+	g := hardGame()
+	err := g.SolveContext(context.Background(), WithSolver(stubSolver{solution: solved.(*Board).grid()}))
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(g.Board(), solved.Board()) {
+		t.Errorf("solution of the custom solver expected:\n%s, got:\n%s", solved, g)
+	}
+}
+
+func TestDefaultSolver(t *testing.T) {
+	defer func(s Solver) { DefaultSolver = s }(DefaultSolver)
+
+	DefaultSolver = DancingLinks{}
+	if err := hardGame().SolveContext(context.Background(), WithMaxNodes(10)); err != ErrSearchLimit {
+		t.Errorf("options should apply to the default solver, got: %v", err)
+	}
+
+	solved := hardGameSolved()
+	DefaultSolver = stubSolver{solution: solved.(*Board).grid()}
+	g := hardGame()
+	if err := g.Solve(); err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(g.Board(), solved.Board()) {
+		t.Errorf("solution of the default solver expected:\n%s, got:\n%s", solved, g)
+	}
+}
+
+func TestBoard_SolveHardest(t *testing.T) {
+	for _, p := range hardestPuzzles {
+		testSolveHardest(t, p.name, gameFromString(p.puzzle))
+		testSolveHardest(t, p.name, gameFromString(p.puzzle), WithCellSelection(SelectLastEmpty))
+	}
+}
+
+func testSolveHardest(t *testing.T, name string, g Game, opts ...SolveOption) {
+	clues := g.Board()
+
+	if err := g.SolveContext(context.Background(), opts...); err != nil {
+		t.Errorf("%s: error not expected, got: %v", name, err)
+		return
+	}
+
+	if !g.IsValid() || g.(*Board).emptyValueIndex() >= 0 {
+		t.Errorf("%s: solution should be valid and complete, got:\n%s", name, g)
+	}
+
+	for r, row := range clues {
+		for c, v := range row {
+			if v > 0 && g.Value(r, c) != v {
+				t.Errorf("%s: clue [%d, %d] changed", name, r, c)
+			}
+		}
+	}
+}
+
+func BenchmarkBoard_SolveEasy(b *testing.B) {
+	benchmarkSolve(b, easyGame())
+}
+
+func BenchmarkBoard_SolveHard(b *testing.B) {
+	benchmarkSolve(b, hardGame())
+}
+
+func BenchmarkBoard_SolveHardest(b *testing.B) {
+	for _, p := range hardestPuzzles {
+		b.Run(p.name, func(b *testing.B) {
+			benchmarkSolve(b, gameFromString(p.puzzle))
+		})
+	}
+}
+
+func BenchmarkBoard_SolveHardestLastEmpty(b *testing.B) {
+	for _, p := range hardestPuzzles {
+		b.Run(p.name, func(b *testing.B) {
+			benchmarkSolve(b, gameFromString(p.puzzle), WithCellSelection(SelectLastEmpty))
+		})
+	}
+}
+
+func benchmarkSolve(b *testing.B, g Game, opts ...SolveOption) {
+	board := g.(*Board)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c := board.clone()
+		if err := c.SolveContext(context.Background(), opts...); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// ------------------------------------------------------ DATA ------------------------------------------------------
+
+// hardestPuzzles are well known puzzles that are hard for either humans or computers
+var hardestPuzzles = []struct {
+	name   string
+	puzzle string
+}{
+	{"AIEscargot", "1....7.9..3..2...8..96..5....53..9...1..8...26....4...3......1..4......7..7...3.."},
+	{"EasterMonster", "1.......2.9.4...5...6...7...5.9.3.......7.......85..4.7.....6...3...9.8...2.....1"},
+	{"Inkala2012", "8..........36......7..9.2...5...7.......457.....1...3...1....68..85...1..9....4.."},
+	{"AntiBruteForce", "..............3.85..1.2.......5.7.....4...1...9.......5......73..2.1........4...9"},
+}
+
+// gameFromString creates the game from the puzzle string, which has to be valid
+func gameFromString(s string) Game {
+	g, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return g
+}
@@ -1,6 +1,7 @@
 package sudoku
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -17,12 +18,20 @@ const (
 // Errors that could occur during user communication with the Game.
 var (
 	errOutOfBoardIndex = errors.New("index is out of board")
-	errWrongInput      = errors.New("wrong input value(s)")
 )
 
+// ErrWrongInput is returned when the input is invalid, such as the board with colliding values or an option out of its
+// range.
+var ErrWrongInput = errors.New("wrong input value(s)")
+
+// ErrNoSolution is returned when the board is valid, but there is no way how to fill all empty cells.
+var ErrNoSolution = errors.New("board has no solution")
+
 // Board is the implementation of the Game interface.
 type Board struct {
 	b []uint
+	p []bool
+	c []uint16
 	e error
 	s bool
 }
@@ -39,9 +48,26 @@ type Game interface {
 	Column(column int) []int
 	Box(boxIndex int) []int
 	Board() [][]int
+	SetEntry(row, column, value int) Game
+	IsGiven(row, column int) bool
+	SetCandidates(row, column int, values []int) Game
+	Candidates(row, column int) []int
+	Compact() string
+	CompactWith(blank rune) string
+	Render(opts RenderOptions) string
 	IsEmpty(row, column int) bool
 	IsValid() bool
-	Solve()
+	Solve() error
+	SolveContext(ctx context.Context, opts ...SolveOption) error
+	SolveWithStats(ctx context.Context, opts ...SolveOption) (SolveStats, error)
+	SolveLogically() ([]Step, error)
+	NextHint() (Hint, error)
+	ApplyHint(h Hint) Game
+	CountSolutions(limit int) (int, error)
+	HasUniqueSolution() bool
+	IsMinimal() bool
+	RedundantClues() []Cell
+	Solutions(ctx context.Context) <-chan Game
 	Error() error
 }
 
@@ -49,6 +75,8 @@ type Game interface {
 func NewBoard() Game {
 	return &Board{
 		b: make([]uint, BoardSize, BoardSize), // board
+		p: make([]bool, BoardSize, BoardSize), // player entries
+		c: make([]uint16, BoardSize),          // candidates
 		s: false,                              // solved
 		e: nil,                                // error
 	}
@@ -71,11 +99,11 @@ func (b *Board) SetValue(row, column, value int) Game {
 
 	// check for value
 	if value < 0 || value > MaxValue {
-		b.e = errWrongInput
+		b.e = ErrWrongInput
 		return b
 	}
 
-	b.b[idx] = uint(value)
+	b.setGiven(idx, uint(value))
 	return b
 }
 
@@ -87,7 +115,7 @@ func (b *Board) SetRow(row int, values []int) Game {
 	}
 
 	if !b.isValidSlice(values) {
-		b.e = errWrongInput
+		b.e = ErrWrongInput
 		return b
 	}
 
@@ -98,7 +126,7 @@ func (b *Board) SetRow(row int, values []int) Game {
 	}
 
 	for _, v := range values {
-		b.b[idx] = uint(v)
+		b.setGiven(idx, uint(v))
 		idx++
 	}
 
@@ -114,7 +142,7 @@ func (b *Board) SetColumn(column int, values []int) Game {
 	}
 
 	if !b.isValidSlice(values) {
-		b.e = errWrongInput
+		b.e = ErrWrongInput
 		return b
 	}
 
@@ -125,7 +153,7 @@ func (b *Board) SetColumn(column int, values []int) Game {
 	}
 
 	for _, v := range values {
-		b.b[idx] = uint(v)
+		b.setGiven(idx, uint(v))
 		idx += BoardSide
 	}
 
@@ -141,7 +169,7 @@ func (b *Board) SetBox(boxIndex int, values []int) Game {
 	}
 
 	if boxIndex < 0 || boxIndex >= BoardSide || !b.isValidSlice(values) {
-		b.e = errWrongInput
+		b.e = ErrWrongInput
 		return b
 	}
 
@@ -154,7 +182,7 @@ func (b *Board) SetBox(boxIndex int, values []int) Game {
 	// i is initialised in the first (outside) cycle, however incremented in the inner one
 	for i, r := 0, 0; r < BoardBoxSize; r, idx = r+1, idx+BoardSide {
 		for c := 0; c < BoardBoxSize; c, i = c+1, i+1 {
-			b.b[idx+c] = uint(values[i])
+			b.setGiven(idx+c, uint(values[i]))
 		}
 	}
 
@@ -169,7 +197,7 @@ func (b *Board) SetBoard(values [][]int) Game {
 	}
 
 	if len(values) != BoardSide {
-		b.e = errWrongInput
+		b.e = ErrWrongInput
 		return b
 	}
 
@@ -179,7 +207,7 @@ func (b *Board) SetBoard(values [][]int) Game {
 
 	// rows checked, but columns not -> validate
 	if !b.IsValid() {
-		b.e = errWrongInput
+		b.e = ErrWrongInput
 	}
 
 	return b
@@ -203,6 +231,100 @@ func (b *Board) IsEmpty(row, column int) bool {
 	return b.b[idx] < 1
 }
 
+// SetEntry method sets the value entered by the player, which is not the part of the puzzle. The cell cannot keep the
+// given value and zero removes the entry. When there is a state error this method returns Game, but there is no
+// behavior.
+func (b *Board) SetEntry(row, column, value int) Game {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return b
+	}
+
+	idx, err := b.index(row, column)
+	if err != nil {
+		b.e = err
+		return b
+	}
+
+	if value < 0 || value > MaxValue || (b.b[idx] > 0 && !b.p[idx]) {
+		b.e = ErrWrongInput
+		return b
+	}
+
+	b.b[idx], b.p[idx] = uint(value), value > 0
+	if value > 0 {
+		b.c[idx] = 0
+	}
+
+	return b
+}
+
+// IsGiven method checks if the cell keeps the value of the puzzle, not the one entered by the player or the solver.
+// When there is a state error this method returns false.
+func (b *Board) IsGiven(row, column int) bool {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return false
+	}
+
+	idx, err := b.index(row, column)
+	if err != nil {
+		b.e = err
+		return false
+	}
+
+	return b.b[idx] > 0 && !b.p[idx]
+}
+
+// SetCandidates method sets the pencil marks of the empty cell, no values remove them. Placing any value into the cell
+// removes its pencil marks as well. When there is a state error this method returns Game, but there is no behavior.
+func (b *Board) SetCandidates(row, column int, values []int) Game {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return b
+	}
+
+	idx, err := b.index(row, column)
+	if err != nil {
+		b.e = err
+		return b
+	}
+
+	var mask uint16
+	for _, v := range values {
+		if v < 1 || v > MaxValue {
+			b.e = ErrWrongInput
+			return b
+		}
+		mask |= digitBit(v)
+	}
+
+	if mask != 0 && b.b[idx] > 0 {
+		b.e = ErrWrongInput
+		return b
+	}
+
+	b.c[idx] = mask
+	return b
+}
+
+// Candidates method returns the pencil marks of the cell in ascending order. When there is a state error this method
+// returns nil.
+func (b *Board) Candidates(row, column int) []int {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return nil
+	}
+
+	idx, err := b.index(row, column)
+	if err != nil {
+		b.e = err
+		return nil
+	}
+
+	return digitsOf(b.c[idx])
+}
+
 // IsValid method checks if the board is valid, which means all values in the row, column and/or box are not duplicated.
 // When there is a state error this method returns false.
 func (b Board) IsValid() bool {
@@ -314,14 +436,116 @@ func (b *Board) Box(boxIndex int) []int {
 	return b.box(boxIndex)
 }
 
-// Solve method solves the Sudoku based on the set values. When there is a state error this method has no behavior.
-func (b Board) Solve() {
+// Solve method solves the Sudoku based on the set values. It returns nil when the board has been solved and
+// ErrNoSolution when the board cannot be completed, in which case the values stay untouched. Any other error means
+// the input is wrong - the state error is returned as it is and an invalid board returns ErrWrongInput.
+func (b *Board) Solve() error {
+	return b.SolveContext(context.Background())
+}
+
+// SolveContext method solves the Sudoku the same way as Solve, however the search is interrupted when the context is
+// done or the search limit set by options is reached. In such a case the context error or ErrSearchLimit is returned
+// and the values stay untouched.
+func (b *Board) SolveContext(ctx context.Context, opts ...SolveOption) error {
+	_, err := b.SolveWithStats(ctx, opts...)
+	return err
+}
+
+// SolveWithStats method solves the Sudoku the same way as SolveContext and returns the statistics of the search
+// as well. When there is a state error this method returns empty statistics and the state error.
+func (b *Board) SolveWithStats(ctx context.Context, opts ...SolveOption) (SolveStats, error) {
 	// do nothing when any error occurred
 	if b.e != nil {
-		return
+		return SolveStats{}, b.e
 	}
+
+	if !b.IsValid() {
+		return SolveStats{}, ErrWrongInput
+	}
+
 	b.s = false // set as not solved
-	b.solve()
+	solution, stats, err := SolveGrid(ctx, newSolveConfig(opts).engine(), b.grid())
+	if err != nil {
+		return stats, err
+	}
+
+	b.setGrid(solution)
+	b.s = true
+	return stats, nil
+}
+
+// CountSolutions method counts the solutions of the board, however the counting stops when the limit is reached.
+// The values of the board stay untouched. When there is a state error this method returns zero and the state error.
+func (b *Board) CountSolutions(limit int) (int, error) {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return 0, b.e
+	}
+
+	if limit < 1 || !b.IsValid() {
+		return 0, ErrWrongInput
+	}
+
+	return countSolutions(b.grid(), limit)
+}
+
+// HasUniqueSolution method checks if the board has exactly one solution. When there is a state error this method
+// returns false.
+func (b *Board) HasUniqueSolution() bool {
+	count, err := b.CountSolutions(2)
+	return err == nil && count == 1
+}
+
+// IsMinimal method checks if the givens have the unique solution, which is lost by removing any of them. The player
+// entries are not taken into account. When there is a state error this method returns false.
+func (b *Board) IsMinimal() bool {
+	return b.uniqueGivens() && len(b.redundantClues(1)) == 0
+}
+
+// RedundantClues method returns the givens, where each one of them can be removed while the solution of the givens
+// stays unique. Removing all of them at once might break the uniqueness though. The player entries are not taken into
+// account and the values of the board stay untouched. When there is a state error or the givens have no unique
+// solution this method returns nil.
+func (b *Board) RedundantClues() []Cell {
+	if !b.uniqueGivens() {
+		return nil
+	}
+
+	return cellsOf(b.redundantClues(BoardSize))
+}
+
+// Solutions method sends all solutions of the board into the returned channel, where each solution is an independent
+// Board copy. The channel is closed when there are no more solutions or the context is done, so the caller should
+// cancel the context when it stops reading early. The values of the board stay untouched. When there is a state
+// error or the board is not valid the channel is closed without any solution.
+func (b *Board) Solutions(ctx context.Context) <-chan Game {
+	solutions := make(chan Game)
+
+	// do nothing when any error occurred
+	if b.e != nil || !b.IsValid() {
+		close(solutions)
+		return solutions
+	}
+
+	// the search runs on its own copy, the board could be changed in the meantime
+	puzzle := b.clone()
+	go func() {
+		defer close(solutions)
+		_, _ = newSolveConfig(nil).engine().Search(ctx, puzzle.grid(), func(g Grid) bool {
+			solution := puzzle.clone()
+			solution.setGrid(g)
+			solution.s = true
+
+			select {
+			case solutions <- solution:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	}()
+
+	return solutions
 }
 
 // String method provides the printable version of Sudoku board.
@@ -349,6 +573,106 @@ func (b Board) index(row, column int) (int, error) {
 	return row*BoardSide + column, nil
 }
 
+func (b Board) clone() *Board {
+	c := &Board{
+		b: make([]uint, BoardSize, BoardSize),
+		p: make([]bool, BoardSize, BoardSize),
+		c: make([]uint16, BoardSize),
+		s: b.s,
+		e: b.e,
+	}
+	copy(c.b, b.b)
+	copy(c.p, b.p)
+	copy(c.c, b.c)
+
+	return c
+}
+
+func (b Board) grid() Grid {
+	var g Grid
+	for idx, v := range b.b {
+		g[idx] = int(v)
+	}
+
+	return g
+}
+
+// setGrid fills the empty cells by the values of the grid, which become player entries.
+func (b *Board) setGrid(g Grid) {
+	for idx, v := range g {
+		if b.b[idx] == 0 && v > 0 {
+			b.b[idx], b.p[idx], b.c[idx] = uint(v), true, 0
+		}
+	}
+}
+
+// setGiven sets the value of the puzzle itself, the placed value removes the candidates of the cell.
+func (b *Board) setGiven(idx int, v uint) {
+	b.b[idx], b.p[idx] = v, false
+	if v > 0 {
+		b.c[idx] = 0
+	}
+}
+
+// givens returns the grid of the puzzle values without the player entries.
+func (b Board) givens() Grid {
+	var g Grid
+	for idx, v := range b.b {
+		if !b.p[idx] {
+			g[idx] = int(v)
+		}
+	}
+
+	return g
+}
+
+// uniqueGivens checks if the givens alone have exactly one solution.
+func (b Board) uniqueGivens() bool {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return false
+	}
+
+	count, err := countSolutions(b.givens(), 2)
+	return err == nil && count == 1
+}
+
+// redundantClues returns indexes of givens, which can be removed while the solution stays unique, up to the limit.
+// The givens have to have the unique solution.
+func (b Board) redundantClues(limit int) []int {
+	var redundant []int
+	g := b.givens()
+	for idx, v := range g {
+		if v == 0 {
+			continue
+		}
+
+		g[idx] = 0
+		count, err := countSolutions(g, 2)
+		g[idx] = v
+
+		if err == nil && count == 1 {
+			redundant = append(redundant, idx)
+			if len(redundant) == limit {
+				break
+			}
+		}
+	}
+
+	return redundant
+}
+
+// countSolutions counts the solutions of the grid by the default solver up to the limit.
+func countSolutions(g Grid, limit int) (int, error) {
+	count := 0
+	_, err := newSolveConfig(nil).engine().Search(context.Background(), g, func(Grid) bool {
+		count++
+		return count < limit
+	})
+
+	return count, err
+}
+
 func (b Board) indexBox(boxIndex int) (int, error) {
 	row := (boxIndex / BoardBoxSize) * BoardBoxSize
 	column := (boxIndex % BoardBoxSize) * BoardBoxSize
@@ -414,31 +738,6 @@ func (b Board) emptyValueIndex() int {
 	return -1
 }
 
-func (b *Board) solve() {
-	// no need to check for error
-	if b.s {
-		return
-	}
-
-	idx := b.emptyValueIndex()
-	if idx < 0 {
-		b.s = true
-		return
-	}
-
-	for !b.s {
-		b.b[idx]++
-		if b.b[idx] > 9 {
-			b.b[idx] = 0 // empty value
-			return
-		}
-
-		if b.IsValid() {
-			b.solve()
-		}
-	}
-}
-
 // limited functionality to Sudoku where values could be within a limit
 func (b Board) isValidSlice(values []int) bool {
 	if len(values) != BoardSide {
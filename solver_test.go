@@ -1,6 +1,7 @@
 package sudoku
 
 import (
+	"context"
 	"reflect"
 	"testing"
 )
@@ -276,6 +277,80 @@ func TestBoard_SetBoard(t *testing.T) {
 	}
 }
 
+func TestBoard_SetEntry(t *testing.T) {
+	g := easyGame()
+	if !g.IsGiven(0, 6) || g.IsGiven(0, 0) {
+		t.Error("givens of the puzzle expected")
+	}
+
+	g.SetEntry(0, 0, 9)
+	if g.Value(0, 0) != 9 || g.IsGiven(0, 0) || g.Error() != nil {
+		t.Errorf("entry 9 expected, got: %d (given %t, error %v)", g.Value(0, 0), g.IsGiven(0, 0), g.Error())
+	}
+
+	g.SetEntry(0, 0, 0)
+	if !g.IsEmpty(0, 0) {
+		t.Error("removed entry expected")
+	}
+
+	// solved values are entries as well
+	if err := g.Solve(); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if g.IsGiven(0, 0) || !g.IsGiven(0, 6) {
+		t.Error("solved values shouldn't be givens")
+	}
+
+	// setting the value makes it the given
+	g.SetValue(0, 0, 9)
+	if !g.IsGiven(0, 0) {
+		t.Error("given expected")
+	}
+
+	if g.SetEntry(0, 6, 1).Error() != ErrWrongInput {
+		t.Errorf("wrong input error expected for the given cell, got: %v", g.Error())
+	}
+
+	if NewBoard().SetEntry(0, 0, MaxValue+1).Error() != ErrWrongInput {
+		t.Error("wrong input error expected for the value")
+	}
+
+	if NewBoard().SetEntry(BoardSide, 0, 1).Error() != errOutOfBoardIndex {
+		t.Error("out of board index error expected")
+	}
+}
+
+func TestBoard_SetCandidates(t *testing.T) {
+	g := easyGame()
+	g.SetCandidates(0, 0, []int{9, 2, 2})
+	if c := g.Candidates(0, 0); !reflect.DeepEqual(c, []int{2, 9}) {
+		t.Errorf("candidates [2 9] expected, got: %v", c)
+	}
+
+	g.SetEntry(0, 0, 9)
+	if c := g.Candidates(0, 0); len(c) != 0 {
+		t.Errorf("placed value should remove candidates, got: %v", c)
+	}
+
+	g.SetEntry(0, 0, 0).SetCandidates(0, 0, []int{1}).SetCandidates(0, 0, nil)
+	if c := g.Candidates(0, 0); len(c) != 0 || g.Error() != nil {
+		t.Errorf("removed candidates expected, got: %v (%v)", c, g.Error())
+	}
+
+	if g.SetCandidates(0, 6, []int{1}).Error() != ErrWrongInput {
+		t.Errorf("wrong input error expected for the filled cell, got: %v", g.Error())
+	}
+
+	if NewBoard().SetCandidates(0, 0, []int{0}).Error() != ErrWrongInput {
+		t.Error("wrong input error expected for the candidate")
+	}
+
+	if NewBoard().SetCandidates(0, BoardSide, nil).Candidates(0, 0) != nil {
+		t.Error("no candidates expected with the state error")
+	}
+}
+
 func TestBoard_Error(t *testing.T) {
 	g := NewBoard()
 	if g.Error() != nil {
@@ -367,8 +442,7 @@ func TestBoard_IsValidBox(t *testing.T) {
 
 func TestBoard_SolveEasy(t *testing.T) {
 	g := easyGame()
-	g.Solve()
-	err := g.Error()
+	err := g.Solve()
 	if err != nil {
 		t.Error(err)
 	}
@@ -381,8 +455,7 @@ func TestBoard_SolveEasy(t *testing.T) {
 
 func TestBoard_SolveHard(t *testing.T) {
 	g := hardGame()
-	g.Solve()
-	err := g.Error()
+	err := g.Solve()
 	if err != nil {
 		t.Error(err)
 	}
@@ -393,6 +466,300 @@ func TestBoard_SolveHard(t *testing.T) {
 	}
 }
 
+func TestBoard_SolveNoSolution(t *testing.T) {
+	g := noSolutionGame()
+	board := g.Board()
+
+	err := g.Solve()
+	if err != ErrNoSolution {
+		t.Errorf("no solution error expected, got: %v", err)
+	}
+
+	if g.Error() != nil {
+		t.Errorf("state error not expected, got: %v", g.Error())
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Errorf("board without solution should stay untouched, got:\n%s", g)
+	}
+}
+
+func TestBoard_SolveWrongInput(t *testing.T) {
+	g := NewBoard().SetValue(-1, 0, 1)
+	if err := g.Solve(); err == nil || err == ErrNoSolution {
+		t.Errorf("state error expected, got: %v", err)
+	}
+
+	g = easyGame().SetValue(3, 0, 9)
+	if err := g.Solve(); err == nil || err == ErrNoSolution {
+		t.Errorf("wrong input error expected for invalid board, got: %v", err)
+	}
+}
+
+func TestBoard_SolveContext(t *testing.T) {
+	g := guessingGame()
+	board := g.Board()
+
+	err := g.SolveContext(context.Background(), WithMaxNodes(10))
+	if err != ErrSearchLimit {
+		t.Errorf("search limit error expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("board should stay untouched when the search limit is reached")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = g.SolveContext(ctx)
+	if err != context.Canceled {
+		t.Errorf("context canceled error expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("board should stay untouched when the context is canceled")
+	}
+
+	// the puzzle solved by singles alone never guesses, the context is checked anyway
+	singles := singlesGame()
+	if err = singles.SolveContext(ctx); err != context.Canceled {
+		t.Errorf("context canceled error expected, got: %v", err)
+	}
+
+	if !reflect.DeepEqual(singles.Board(), singlesGame().Board()) {
+		t.Error("board should stay untouched when the context is canceled")
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	err = g.SolveContext(ctx)
+	if err != context.DeadlineExceeded {
+		t.Errorf("deadline exceeded error expected, got: %v", err)
+	}
+
+	err = g.SolveContext(context.Background(), WithMaxNodes(0))
+	if err != nil {
+		t.Errorf("error not expected without the search limit, got: %v", err)
+	}
+
+	if !g.IsValid() || g.(*Board).emptyValueIndex() >= 0 {
+		t.Errorf("sudoku solved expected, got:\n%s", g)
+	}
+}
+
+func TestBoard_SolveWithStats(t *testing.T) {
+	for _, solver := range []Solver{Backtracking{}, DancingLinks{}} {
+		g := guessingGame()
+		empty := 0
+		for _, row := range g.Board() {
+			for _, v := range row {
+				if v == 0 {
+					empty++
+				}
+			}
+		}
+
+		stats, err := g.SolveWithStats(context.Background(), WithSolver(solver))
+		if err != nil {
+			t.Errorf("%T: error not expected, got: %v", solver, err)
+		}
+
+		if stats.Propagated+stats.Guessed != empty {
+			t.Errorf("%T: %d filled cells expected, got: %+v", solver, empty, stats)
+		}
+
+		if stats.Guessed < 1 || stats.Nodes < stats.Guessed || stats.MaxDepth < stats.Guessed {
+			t.Errorf("%T: guessing expected for the AI Escargot, got: %+v", solver, stats)
+		}
+
+		if stats.Elapsed <= 0 {
+			t.Errorf("%T: elapsed time expected, got: %v", solver, stats.Elapsed)
+		}
+	}
+
+	stats, err := singlesGame().SolveWithStats(context.Background())
+	if err != nil || stats.Guessed != 0 || stats.Propagated == 0 {
+		t.Errorf("newspaper puzzle should be solved by propagation only, got: %+v, %v", stats, err)
+	}
+
+	stats, err = NewBoard().SetValue(-1, 0, 1).SolveWithStats(context.Background())
+	if err == nil || stats != (SolveStats{}) {
+		t.Errorf("state error and empty statistics expected, got: %+v, %v", stats, err)
+	}
+}
+
+func TestBoard_CountSolutions(t *testing.T) {
+	g := easyGame()
+	board := g.Board()
+
+	count, err := g.CountSolutions(10)
+	if err != nil {
+		t.Errorf("error not expected, got: %v", err)
+	}
+
+	if count != 1 {
+		t.Errorf("easy game should have exactly one solution, got: %d", count)
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("counting solutions shouldn't change the board")
+	}
+
+	count, err = noSolutionGame().CountSolutions(10)
+	if err != nil || count != 0 {
+		t.Errorf("no solution expected, got: %d, %v", count, err)
+	}
+
+	// removing the clues makes room for more solutions
+	count, err = easyGame().SetRow(0, []int{0, 0, 0, 0, 0, 0, 0, 0, 0}).CountSolutions(5)
+	if err != nil || count != 5 {
+		t.Errorf("counting should stop at the limit 5, got: %d, %v", count, err)
+	}
+
+	if _, err = easyGame().CountSolutions(0); err == nil {
+		t.Error("error expected when the limit is not positive")
+	}
+
+	if _, err = NewBoard().SetValue(-1, 0, 1).CountSolutions(1); err == nil {
+		t.Error("state error expected")
+	}
+}
+
+func TestBoard_HasUniqueSolution(t *testing.T) {
+	if !easyGame().HasUniqueSolution() {
+		t.Error("easy game should have a unique solution")
+	}
+
+	if !hardGame().HasUniqueSolution() {
+		t.Error("hard game should have a unique solution")
+	}
+
+	if noSolutionGame().HasUniqueSolution() {
+		t.Error("game without a solution cannot have a unique one")
+	}
+
+	if easyGame().SetRow(0, []int{0, 0, 0, 0, 0, 0, 0, 0, 0}).HasUniqueSolution() {
+		t.Error("game with removed clues shouldn't have a unique solution")
+	}
+}
+
+func TestBoard_IsMinimal(t *testing.T) {
+	g := easyGame()
+	if g.IsMinimal() {
+		t.Error("easy game shouldn't be minimal")
+	}
+
+	// removing the redundant clues one by one, while the solution stays unique, gives the minimal puzzle
+	for cells := g.RedundantClues(); len(cells) > 0; cells = g.RedundantClues() {
+		g.SetValue(cells[0].Row, cells[0].Column, 0)
+	}
+
+	if !g.IsMinimal() || !g.HasUniqueSolution() {
+		t.Errorf("minimal game with unique solution expected, got:\n%s", g)
+	}
+
+	// the player entries are not clues
+	if err := g.Solve(); err != nil || !g.IsMinimal() {
+		t.Errorf("solved minimal game should stay minimal, got: %v", err)
+	}
+
+	if ambiguousGame().IsMinimal() {
+		t.Error("game without unique solution cannot be minimal")
+	}
+
+	if NewBoard().SetValue(BoardSide, 0, 1).IsMinimal() {
+		t.Error("game with state error cannot be minimal")
+	}
+}
+
+func TestBoard_RedundantClues(t *testing.T) {
+	g := easyGame()
+	board := g.Board()
+	redundant := g.RedundantClues()
+	if len(redundant) == 0 {
+		t.Fatal("redundant clues of easy game expected")
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("redundant clues shouldn't change the board")
+	}
+
+	for r := 0; r < BoardSide; r++ {
+		for c := 0; c < BoardSide; c++ {
+			if g.IsEmpty(r, c) {
+				continue
+			}
+
+			v := g.Value(r, c)
+			unique := g.SetValue(r, c, 0).HasUniqueSolution()
+			g.SetValue(r, c, v)
+
+			if unique != containsCell(redundant, Cell{Row: r, Column: c}) {
+				t.Errorf("clue (%d, %d) redundant %t expected, got: %v", r, c, unique, redundant)
+			}
+		}
+	}
+
+	// the player entries are not clues
+	solved := easyGame()
+	if err := solved.Solve(); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if r := solved.RedundantClues(); !reflect.DeepEqual(r, redundant) {
+		t.Errorf("redundant givens %v expected, got: %v", redundant, r)
+	}
+
+	if ambiguousGame().RedundantClues() != nil {
+		t.Error("game without unique solution shouldn't have redundant clues")
+	}
+}
+
+func TestBoard_Solutions(t *testing.T) {
+	g := ambiguousGame()
+	board := g.Board()
+
+	expected, err := g.CountSolutions(1000)
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	seen := make(map[string]bool)
+	for s := range g.Solutions(context.Background()) {
+		if !s.IsValid() || s.(*Board).emptyValueIndex() >= 0 {
+			t.Errorf("solution should be valid and complete, got:\n%s", s)
+		}
+
+		seen[s.(*Board).String()] = true
+		s.SetValue(0, 0, 0) // solutions are independent copies
+	}
+
+	if len(seen) != expected {
+		t.Errorf("%d distinct solutions expected, got: %d", expected, len(seen))
+	}
+
+	if !reflect.DeepEqual(g.Board(), board) {
+		t.Error("iterating over solutions shouldn't change the board")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	solutions := g.Solutions(ctx)
+	<-solutions
+	cancel()
+	for range solutions {
+		// drain until the search notices the cancellation
+	}
+
+	count := 0
+	for range NewBoard().SetValue(-1, 0, 1).Solutions(context.Background()) {
+		count++
+	}
+
+	if count != 0 {
+		t.Errorf("no solution expected for the board with a state error, got: %d", count)
+	}
+}
+
 // ------------------------------------------------------ DATA ------------------------------------------------------
 
 func easyGame() Game {
@@ -451,6 +818,58 @@ func hardGameSolved() Game {
 	})
 }
 
+// singlesGame is the newspaper puzzle, which can be solved by naked and hidden singles only
+func singlesGame() Game {
+	return NewBoard().SetBoard([][]int{
+		{0, 0, 3, 0, 2, 0, 6, 0, 0},
+		{9, 0, 0, 3, 0, 5, 0, 0, 1},
+		{0, 0, 1, 8, 0, 6, 4, 0, 0},
+		{0, 0, 8, 1, 0, 2, 9, 0, 0},
+		{7, 0, 0, 0, 0, 0, 0, 0, 8},
+		{0, 0, 6, 7, 0, 8, 2, 0, 0},
+		{0, 0, 2, 6, 0, 9, 5, 0, 0},
+		{8, 0, 0, 2, 0, 3, 0, 0, 9},
+		{0, 0, 5, 0, 1, 0, 3, 0, 0},
+	})
+}
+
+// guessingGame is the AI Escargot puzzle, which cannot be solved without guessing
+func guessingGame() Game {
+	return NewBoard().SetBoard([][]int{
+		{1, 0, 0, 0, 0, 7, 0, 9, 0},
+		{0, 3, 0, 0, 2, 0, 0, 0, 8},
+		{0, 0, 9, 6, 0, 0, 5, 0, 0},
+		{0, 0, 5, 3, 0, 0, 9, 0, 0},
+		{0, 1, 0, 0, 8, 0, 0, 0, 2},
+		{6, 0, 0, 0, 0, 4, 0, 0, 0},
+		{3, 0, 0, 0, 0, 0, 0, 1, 0},
+		{0, 4, 0, 0, 0, 0, 0, 0, 7},
+		{0, 0, 7, 0, 0, 0, 3, 0, 0},
+	})
+}
+
+// ambiguousGame is the easy game with two clues removed, so it has more solutions
+func ambiguousGame() Game {
+	return easyGame().
+		SetValue(0, 6, 0).
+		SetValue(1, 4, 0)
+}
+
+// noSolutionGame is valid, but the last cell cannot hold any value
+func noSolutionGame() Game {
+	return NewBoard().SetBoard([][]int{
+		{0, 0, 0, 0, 0, 0, 0, 0, 9},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{1, 2, 3, 4, 5, 6, 7, 8, 0},
+	})
+}
+
 func game2() Game {
 	return NewBoard().
 		SetValue(1, 5, 3).
@@ -0,0 +1,78 @@
+package sudoku
+
+// Symmetry is the layout of clues in generated puzzles. Clues are removed in orbits, which are the cells mapped onto
+// each other by the symmetry, so the givens keep the layout.
+type Symmetry int
+
+// Symmetries of the clue layout.
+const (
+	NoSymmetry         Symmetry = iota // any layout
+	RotationalSymmetry                 // 180° rotation around the centre
+	MirrorSymmetry                     // reflection across the vertical axis
+	DiagonalSymmetry                   // reflection across the main diagonal
+	DihedralSymmetry                   // all rotations and reflections of the square
+)
+
+var symmetryNames = [...]string{"None", "Rotational", "Mirror", "Diagonal", "Dihedral"}
+
+// String method returns the name of the symmetry.
+func (s Symmetry) String() string {
+	if s < NoSymmetry || s > DihedralSymmetry {
+		return "Unknown Symmetry"
+	}
+
+	return symmetryNames[s]
+}
+
+// ------------------------------------------------- PRIVATE METHODS -------------------------------------------------
+
+// images returns the cells the symmetry maps the cell onto, the cell itself included.
+func (s Symmetry) images(idx int) []int {
+	const last = BoardSide - 1
+	r, c := rowOf[idx], columnOf[idx]
+
+	var cells [][2]int
+	switch s {
+	case RotationalSymmetry:
+		cells = [][2]int{{r, c}, {last - r, last - c}}
+	case MirrorSymmetry:
+		cells = [][2]int{{r, c}, {r, last - c}}
+	case DiagonalSymmetry:
+		cells = [][2]int{{r, c}, {c, r}}
+	case DihedralSymmetry:
+		cells = [][2]int{
+			{r, c}, {c, last - r}, {last - r, last - c}, {last - c, r},
+			{r, last - c}, {last - r, c}, {c, r}, {last - c, last - r},
+		}
+	default:
+		cells = [][2]int{{r, c}}
+	}
+
+	var images []int
+	for _, cell := range cells {
+		if i := cell[0]*BoardSide + cell[1]; !contains(images, i) {
+			images = append(images, i)
+		}
+	}
+
+	return images
+}
+
+// orbits splits the board into groups of cells mapped onto each other by the symmetry.
+func (s Symmetry) orbits() [][]int {
+	var orbits [][]int
+	var seen [BoardSize]bool
+	for idx := 0; idx < BoardSize; idx++ {
+		if seen[idx] {
+			continue
+		}
+
+		orbit := s.images(idx)
+		for _, i := range orbit {
+			seen[i] = true
+		}
+		orbits = append(orbits, orbit)
+	}
+
+	return orbits
+}
@@ -0,0 +1,51 @@
+package sudoku
+
+import "strings"
+
+// DefaultBlank is the character of empty cells in the compact format.
+const DefaultBlank = '.'
+
+// Compact method returns the board as one line of 81 characters, where the empty cell is '.'.
+func (b Board) Compact() string {
+	return b.CompactWith(DefaultBlank)
+}
+
+// CompactWith method returns the board as one line of 81 characters with the given character of empty cells. Parse
+// reads the line back only when the character is one of the blanks it accepts.
+func (b Board) CompactWith(blank rune) string {
+	sb := strings.Builder{}
+	sb.Grow(BoardSize)
+
+	for _, v := range b.b {
+		if v == 0 {
+			sb.WriteRune(blank)
+			continue
+		}
+		sb.WriteByte(byte('0' + v))
+	}
+
+	return sb.String()
+}
+
+// MarshalText method implements the encoding.TextMarshaler interface by the compact format. When there is a state
+// error this method returns nil and the state error.
+func (b Board) MarshalText() ([]byte, error) {
+	// do nothing when any error occurred
+	if b.e != nil {
+		return nil, b.e
+	}
+
+	return []byte(b.Compact()), nil
+}
+
+// UnmarshalText method implements the encoding.TextUnmarshaler interface by the Parse function. The board is
+// replaced including its state error, however it stays untouched when the text cannot be parsed.
+func (b *Board) UnmarshalText(text []byte) error {
+	g, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = *g.(*Board)
+	return nil
+}
@@ -0,0 +1,65 @@
+package sudoku
+
+import (
+	"encoding"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ encoding.TextMarshaler   = Board{}
+	_ encoding.TextUnmarshaler = &Board{}
+)
+
+func TestBoard_Compact(t *testing.T) {
+	for _, p := range hardestPuzzles {
+		g := gameFromString(p.puzzle)
+		if c := g.Compact(); c != p.puzzle {
+			t.Errorf("%s: compact format expected: %s, got: %s", p.name, p.puzzle, c)
+		}
+
+		zeros := g.CompactWith('0')
+		other, err := Parse(zeros)
+		if err != nil {
+			t.Fatalf("%s: error not expected, got: %v", p.name, err)
+		}
+
+		if !reflect.DeepEqual(other.Board(), g.Board()) {
+			t.Errorf("%s: the same board expected after round trip of %s", p.name, zeros)
+		}
+	}
+
+	if c := easyGameSolved().CompactWith('_'); len(c) != BoardSize || c[0] == '_' {
+		t.Errorf("solved board without blanks expected, got: %s", c)
+	}
+}
+
+func TestBoard_MarshalText(t *testing.T) {
+	g := easyGame().(*Board)
+	text, err := g.MarshalText()
+	if err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	b := NewBoard().SetValue(BoardSide, 0, 1).(*Board)
+	if _, err = b.MarshalText(); err != errOutOfBoardIndex {
+		t.Errorf("state error expected, got: %v", err)
+	}
+
+	// the error is replaced by the unmarshalled board
+	if err = b.UnmarshalText(text); err != nil {
+		t.Fatalf("error not expected, got: %v", err)
+	}
+
+	if b.Error() != nil || !reflect.DeepEqual(b.Board(), g.Board()) {
+		t.Errorf("board expected:\n%s, got:\n%s (%v)", g, b, b.Error())
+	}
+
+	if err = b.UnmarshalText([]byte("123")); err == nil {
+		t.Error("parse error expected")
+	}
+
+	if !reflect.DeepEqual(b.Board(), g.Board()) {
+		t.Error("board should stay untouched on the parse error")
+	}
+}